@@ -58,6 +58,7 @@ func (transport monteCarloTransport) RoundTrip(req *http.Request) (*http.Respons
 
 type UUID string
 type JSONString string
+type DateTime string
 
 type BqTestDiagnostic struct {
 	Cause           string
@@ -101,6 +102,12 @@ type GetWarehouse struct {
 			Type      string `json:"type"`
 			CreatedOn string `json:"createdOn"`
 			UpdatedOn string `json:"updatedOn"`
+			Details   struct {
+				Host     *string `json:"host"`
+				Port     *int64  `json:"port"`
+				Database *string `json:"database"`
+				Project  *string `json:"project"`
+			} `json:"connectionDetails"`
 		} `json:"connections"`
 		DataCollector struct {
 			Uuid string `json:"uuid"`
@@ -112,7 +119,7 @@ const BqConnectionType = "bigquery"
 const BqConnectionTypeResponse = "BIGQUERY"
 const TrxConnectionType = "transactional-db"
 const TrxConnectionTypeResponse = "TRANSACTIONAL_DB"
-const GetWarehouseQuery string = "query getWarehouse($uuid: UUID) { getWarehouse(uuid: $uuid) { name,connections{uuid,type,createdOn,updatedOn},dataCollector{uuid} } }"
+const GetWarehouseQuery string = "query getWarehouse($uuid: UUID) { getWarehouse(uuid: $uuid) { name,connections{uuid,type,createdOn,updatedOn,connectionDetails{host,port,database,project}},dataCollector{uuid} } }"
 
 type RemoveConnection struct {
 	RemoveConnection struct {
@@ -151,30 +158,56 @@ type TagPair struct {
 type TagKeyValuePairInput TagPair
 type TagKeyValuePairOutput TagPair
 
+type DomainRuleInput struct {
+	Operator       string                 `json:"operator"`
+	Tags           []TagKeyValuePairInput `json:"tags"`
+	Assignments    []string               `json:"assignments"`
+	WarehouseUuids []UUID                 `json:"warehouseUuids"`
+	Exclusions     []string               `json:"exclusions"`
+}
+
+type DomainRuleOutput struct {
+	Operator       string                  `json:"operator"`
+	Tags           []TagKeyValuePairOutput `json:"tags"`
+	Assignments    []string                `json:"assignments"`
+	WarehouseUuids []string                `json:"warehouseUuids"`
+	Exclusions     []string                `json:"exclusions"`
+}
+
 type CreateOrUpdateDomain struct {
 	CreateOrUpdateDomain struct {
 		Domain struct {
 			Assignments []string
 			Tags        []TagKeyValuePairOutput
+			DomainRules []DomainRuleOutput
 			Name        string
 			Description string
 			Uuid        string
 		}
-	} `graphql:"createOrUpdateDomain(assignments: $assignments, tags: $tags, name: $name, description: $description, uuid: $uuid)"`
+	} `graphql:"createOrUpdateDomain(assignments: $assignments, tags: $tags, domainRules: $domainRules, name: $name, description: $description, uuid: $uuid)"`
+}
+
+type Domain struct {
+	Uuid           string                  `json:"uuid"`
+	Name           string                  `json:"name"`
+	Description    string                  `json:"description"`
+	CreatedByEmail string                  `json:"createdByEmail"`
+	Tags           []TagKeyValuePairOutput `json:"tags"`
+	Assignments    []string                `json:"assignments"`
+	DomainRules    []DomainRuleOutput      `json:"domainRules"`
 }
 
 type GetDomain struct {
-	GetDomain *struct {
-		Uuid           string                  `json:"uuid"`
-		Name           string                  `json:"name"`
-		Description    string                  `json:"description"`
-		CreatedByEmail string                  `json:"createdByEmail"`
-		Tags           []TagKeyValuePairOutput `json:"tags"`
-		Assignments    []string                `json:"assignments"`
-	} `json:"getDomain"`
+	GetDomain *Domain `json:"getDomain"`
+}
+
+const GetDomainQuery string = "query getDomain($uuid: UUID!) { getDomain(uuid: $uuid) { uuid,name,description,tags{name,value},assignments,domainRules{operator,tags{name,value},assignments,warehouseUuids,exclusions},createdByEmail } }"
+
+type GetAllDomains struct {
+	GetAllDomains []Domain `json:"getAllDomains"`
 }
 
-const GetDomainQuery string = "query getDomain($uuid: UUID!) { getDomain(uuid: $uuid) { uuid,name,description,tags{name,value},assignments,createdByEmail } }"
+const GetAllDomainsQuery string = "query getAllDomains { getAllDomains { uuid,name,description,tags{name,value},assignments,domainRules{operator,tags{name,value},assignments,warehouseUuids,exclusions},createdByEmail } }"
 
 type DeleteDomain struct {
 	DeleteDomain struct {
@@ -222,6 +255,54 @@ type GetTables struct {
 	} `graphql:"getTables(dwId: $dwId, first: $first, after: $after, isDeleted: $isDeleted, isExcluded: $isExcluded)"`
 }
 
+type GetKeyAssetTables struct {
+	GetTables struct {
+		Edges []struct {
+			Node struct {
+				Mcon            string
+				ProjectName     string
+				Dataset         string
+				TableId         string
+				IsImportant     bool
+				ImportanceScore float64
+			}
+		}
+		PageInfo struct {
+			StartCursor string
+			EndCursor   string
+			HasNextPage bool
+		}
+	} `graphql:"getTables(dwId: $dwId, first: $first, after: $after, isDeleted: $isDeleted, isExcluded: $isExcluded)"`
+}
+
+type TableState struct {
+	Mcon       string `json:"mcon"`
+	IsDeleted  bool   `json:"isDeleted"`
+	IsExcluded bool   `json:"isExcluded"`
+}
+
+type GetTableState struct {
+	GetTable *TableState `json:"getTable"`
+}
+
+const GetTableStateQuery string = "query getTable($mcon: String!) { getTable(mcon: $mcon) { mcon,isDeleted,isExcluded } }"
+
+type GetDatasets struct {
+	GetDatasets struct {
+		Edges []struct {
+			Node struct {
+				Project string
+				Dataset string
+			}
+		}
+		PageInfo struct {
+			StartCursor string
+			EndCursor   string
+			HasNextPage bool
+		}
+	} `graphql:"getDatasets(dwId: $dwId, first: $first, after: $after)"`
+}
+
 type AuthorizationGroupUser struct {
 	CognitoUserId string
 	Email         string
@@ -257,6 +338,30 @@ type DeleteAuthorizationGroup struct {
 	} `graphql:"deleteAuthorizationGroup(name: $name)"`
 }
 
+type AuthorizationRole struct {
+	Name        string
+	Label       string
+	Description string
+	IsManaged   bool
+	Permissions []string
+}
+
+type GetAuthorizationRoles struct {
+	GetAuthorizationRoles []AuthorizationRole `graphql:"getAuthorizationRoles"`
+}
+
+type CreateOrUpdateAuthorizationRole struct {
+	CreateOrUpdateAuthorizationRole struct {
+		AuthorizationRole AuthorizationRole
+	} `graphql:"createOrUpdateAuthorizationRole(name: $name, label: $label, description: $description, permissions: $permissions)"`
+}
+
+type DeleteAuthorizationRole struct {
+	DeleteAuthorizationRole struct {
+		Deleted int
+	} `graphql:"deleteAuthorizationRole(name: $name)"`
+}
+
 type User struct {
 	CognitoUserId string
 	Email         string
@@ -296,6 +401,41 @@ type UpdateUserAuthorizationGroupMembership struct {
 	} `graphql:"updateUserAuthorizationGroupMembership(memberUserId: $memberUserId, groupNames: $groupNames)"`
 }
 
+type UserInvite struct {
+	Id        string
+	Email     string
+	State     string
+	ExpiresAt string
+	Groups    []string
+}
+
+type GetInvitesInAccount struct {
+	GetInvitesInAccount struct {
+		Edges []struct {
+			Node UserInvite
+		}
+		PageInfo struct {
+			StartCursor string
+			EndCursor   string
+			HasNextPage bool
+		}
+	} `graphql:"getInvitesInAccount(first: $first, after: $after)"`
+}
+
+type SendUserInvite struct {
+	SendUserInvite struct {
+		Invites        []UserInvite
+		ExistingUsers  []string
+		AlreadyInvited []string
+	} `graphql:"sendUserInvite(emails: $emails, groups: $groups)"`
+}
+
+type RemoveUserInvite struct {
+	RemoveUserInvite struct {
+		Success bool
+	} `graphql:"removeUserInvite(email: $email)"`
+}
+
 type CreateOrUpdateComparisonRule struct {
 	CreateOrUpdateComparisonRule struct {
 		CustomRule struct {
@@ -336,6 +476,7 @@ type CreateOrUpdateServiceApiToken struct {
 type TokenMetadata struct {
 	Id                string
 	Comment           string
+	DisplayName       string
 	CreatedBy         string
 	CreationTime      string
 	Email             string
@@ -355,3 +496,435 @@ type DeleteAccessToken struct {
 		Success bool
 	} `graphql:"deleteAccessToken(tokenId: $tokenId)"`
 }
+
+type TestLookerCredentials struct {
+	TestLookerCredentials struct {
+		Key     string
+		Success bool
+	} `graphql:"testLookerCredentials(baseUrl: $baseUrl, clientId: $clientId, clientSecret: $clientSecret, verifySsl: $verifySsl)"`
+}
+
+type TestTableauCredentials struct {
+	TestTableauCredentials struct {
+		Key     string
+		Success bool
+	} `graphql:"testTableauCredentials(serverName: $serverName, siteName: $siteName, username: $username, password: $password, tokenName: $tokenName, tokenValue: $tokenValue, verifySsl: $verifySsl)"`
+}
+
+type PowerBIAuthModeEnum string
+
+type TestPowerBiCredentials struct {
+	TestPowerBiCredentials struct {
+		Key     string
+		Success bool
+	} `graphql:"testPowerBiCredentials(authMode: $authMode, tenantId: $tenantId, clientId: $clientId, clientSecret: $clientSecret, username: $username, password: $password)"`
+}
+
+type BiConnection struct {
+	Uuid      string
+	Type      string
+	CreatedOn string
+	UpdatedOn string
+}
+
+type BiContainer struct {
+	Uuid          string
+	Name          string
+	DataCollector struct {
+		Uuid string
+	}
+	Connections []BiConnection
+}
+
+type AddBiConnection struct {
+	AddBiConnection struct {
+		Connection struct {
+			Uuid        string
+			CreatedOn   string
+			BiContainer struct {
+				Uuid string
+			}
+		}
+	} `graphql:"addBiConnection(connectionType: $connectionType, dcId: $dcId, key: $key, name: $name)"`
+}
+
+type GetBiContainers struct {
+	GetUser struct {
+		Account struct {
+			Bi []BiContainer
+		}
+	} `graphql:"getUser"`
+}
+
+const LookerConnectionType = "looker"
+const LookerConnectionTypeResponse = "LOOKER"
+const TableauConnectionType = "tableau"
+const TableauConnectionTypeResponse = "TABLEAU"
+const PowerBiConnectionType = "power-bi"
+const PowerBiConnectionTypeResponse = "POWER_BI"
+
+type TestAirflowCredentials struct {
+	TestAirflowCredentials struct {
+		Key     string
+		Success bool
+	} `graphql:"testAirflowCredentials(hostName: $hostName, username: $username, password: $password)"`
+}
+
+type TestFivetranCredentials struct {
+	TestFivetranCredentials struct {
+		Key     string
+		Success bool
+	} `graphql:"testFivetranCredentials(fivetranApiKey: $fivetranApiKey, fivetranApiPassword: $fivetranApiPassword, fivetranBaseUrl: $fivetranBaseUrl)"`
+}
+
+type TestDatabricksJobsCredentials struct {
+	TestDatabricksJobsCredentials struct {
+		Key     string
+		Success bool
+	} `graphql:"testDatabricksJobsCredentials(workspaceUrl: $workspaceUrl, workspaceId: $workspaceId, token: $token)"`
+}
+
+type IntegrationKeyScope string
+
+const AirflowCallbacksKeyScope IntegrationKeyScope = "AirflowCallbacks"
+
+type CreateIntegrationKey struct {
+	CreateIntegrationKey struct {
+		Key struct {
+			Id     string
+			Secret string
+		}
+	} `graphql:"createIntegrationKey(description: $description, scope: $scope, warehouseIds: $warehouseIds)"`
+}
+
+type DeleteIntegrationKey struct {
+	DeleteIntegrationKey struct {
+		Deleted bool
+	} `graphql:"deleteIntegrationKey(keyId: $keyId)"`
+}
+
+const AirflowConnectionType = "airflow"
+const AirflowConnectionTypeResponse = "AIRFLOW"
+const FivetranConnectionType = "fivetran"
+const FivetranConnectionTypeResponse = "FIVETRAN"
+const DatabricksJobsConnectionType = "databricks-jobs"
+const DatabricksJobsConnectionTypeResponse = "DATABRICKS_JOBS"
+
+type TestDbtCloudCredentials struct {
+	TestDbtCloudCredentials struct {
+		Key     string
+		Success bool
+	} `graphql:"testDbtCloudCredentials(dbtCloudAccountId: $dbtCloudAccountId, dbtCloudApiToken: $dbtCloudApiToken, dbtCloudBaseUrl: $dbtCloudBaseUrl)"`
+}
+
+type UpdateDbtCloudConnectionSettings struct {
+	UpdateDbtCloudConnectionSettings struct {
+		Success bool
+	} `graphql:"updateDbtCloudConnectionSettings(connectionId: $connectionId, webhooksEnabled: $webhooksEnabled, projectIds: $projectIds, jobIds: $jobIds)"`
+}
+
+type GetDbtCloudConnectionSettings struct {
+	GetDbtCloudConnectionSettings struct {
+		WebhooksEnabled bool
+		ProjectIds      []string
+		JobIds          []string
+	} `graphql:"getDbtCloudConnectionSettings(connectionId: $connectionId)"`
+}
+
+const DbtCloudConnectionType = "dbt-cloud"
+const DbtCloudConnectionTypeResponse = "DBT_CLOUD"
+
+type DbtProject struct {
+	Uuid        string
+	ProjectName string
+	Source      string
+	Warehouse   struct {
+		Uuid string
+	}
+}
+
+type CreateDbtProject struct {
+	CreateDbtProject struct {
+		DbtProject DbtProject
+	} `graphql:"createDbtProject(projectName: $projectName, source: $source, dwId: $dwId)"`
+}
+
+type GetDbtProjects struct {
+	GetDbtProjects struct {
+		Edges []struct {
+			Node DbtProject
+		}
+		PageInfo struct {
+			StartCursor string
+			EndCursor   string
+			HasNextPage bool
+		}
+	} `graphql:"getDbtProjects(first: $first, after: $after)"`
+}
+
+type DeleteDbtProject struct {
+	DeleteDbtProject struct {
+		Success bool
+	} `graphql:"deleteDbtProject(dbtProjectUuid: $dbtProjectUuid)"`
+}
+
+type ObjectProperty struct {
+	PropertyName  string `json:"propertyName"`
+	PropertyValue string `json:"propertyValue"`
+}
+
+type ObjectPropertyInput ObjectProperty
+
+type LineageNode struct {
+	Mcon        string           `json:"mcon"`
+	ObjectType  string           `json:"objectType"`
+	ObjectId    string           `json:"objectId"`
+	DisplayName string           `json:"displayName"`
+	Properties  []ObjectProperty `json:"properties"`
+}
+
+type CreateOrUpdateLineageNode struct {
+	CreateOrUpdateLineageNode struct {
+		Node struct {
+			Mcon string
+		}
+	} `graphql:"createOrUpdateLineageNode(objectType: $objectType, objectId: $objectId, name: $name, resourceId: $resourceId, properties: $properties)"`
+}
+
+type GetLineageNode struct {
+	GetLineageNode *LineageNode `json:"getLineageNode"`
+}
+
+const GetLineageNodeQuery string = "query getLineageNode($mcon: String!) { getLineageNode(mcon: $mcon) { mcon,objectType,objectId,displayName,properties{propertyName,propertyValue} } }"
+
+type DeleteLineageNode struct {
+	DeleteLineageNode struct {
+		ObjectsDeleted int
+		NodesDeleted   int
+		EdgesDeleted   int
+	} `graphql:"deleteLineageNode(mcon: $mcon)"`
+}
+
+type NodeInput struct {
+	ObjectType string `json:"objectType"`
+	ObjectId   string `json:"objectId"`
+	ResourceId UUID   `json:"resourceId"`
+}
+
+type LineageEdge struct {
+	ExpireAt *string `json:"expireAt"`
+	IsCustom bool    `json:"isCustom"`
+}
+
+type CreateOrUpdateLineageEdge struct {
+	CreateOrUpdateLineageEdge struct {
+		Edge struct {
+			ExpireAt *string
+		}
+	} `graphql:"createOrUpdateLineageEdge(source: $source, destination: $destination, expireAt: $expireAt)"`
+}
+
+type GetLineageEdge struct {
+	GetLineageEdge *LineageEdge `json:"getLineageEdge"`
+}
+
+const GetLineageEdgeQuery string = "query getLineageEdge($source: NodeInput!, $destination: NodeInput!) { getLineageEdge(source: $source, destination: $destination) { expireAt,isCustom } }"
+
+type DeleteLineageEdge struct {
+	DeleteLineageEdge struct {
+		Success bool
+	} `graphql:"deleteLineageEdge(source: $source, destination: $destination)"`
+}
+
+type InputObjectProperty struct {
+	MconId        string `json:"mconId"`
+	PropertyName  string `json:"propertyName"`
+	PropertyValue string `json:"propertyValue"`
+}
+
+type BulkCreateOrUpdateObjectProperties struct {
+	BulkCreateOrUpdateObjectProperties struct {
+		Success bool
+	} `graphql:"bulkCreateOrUpdateObjectProperties(inputObjectProperties: $inputObjectProperties)"`
+}
+
+type GetObjectProperties struct {
+	GetObjectProperties struct {
+		Edges []struct {
+			Node ObjectProperty
+		}
+		PageInfo struct {
+			StartCursor string
+			EndCursor   string
+			HasNextPage bool
+		}
+	} `graphql:"getObjectProperties(mconId: $mconId, first: $first, after: $after)"`
+}
+
+type DeleteObjectProperty struct {
+	DeleteObjectProperty struct {
+		Success bool
+	} `graphql:"deleteObjectProperty(mconId: $mconId, propertyName: $propertyName)"`
+}
+
+type CollectionBlockMode string
+type CollectionType string
+
+type CollectionBlock struct {
+	Uuid            string
+	ResourceId      string
+	Project         *string
+	Dataset         *string
+	TablePattern    *string
+	Mode            string
+	CollectionTypes []string
+}
+
+type CreateOrUpdateCollectionBlock struct {
+	CreateOrUpdateCollectionBlock struct {
+		CollectionBlock CollectionBlock
+	} `graphql:"createOrUpdateCollectionBlock(uuid: $uuid, resourceId: $resourceId, project: $project, dataset: $dataset, tablePattern: $tablePattern, mode: $mode, collectionTypes: $collectionTypes)"`
+}
+
+type GetCollectionBlocks struct {
+	GetCollectionBlocks []CollectionBlock `graphql:"getCollectionBlocks(resourceId: $resourceId)"`
+}
+
+type DeleteCollectionBlock struct {
+	DeleteCollectionBlock struct {
+		Success bool
+	} `graphql:"deleteCollectionBlock(uuid: $uuid)"`
+}
+
+type KeyAssetOverride struct {
+	IsKeyAsset bool   `json:"isKeyAsset"`
+	Reason     string `json:"reason"`
+}
+
+type Table struct {
+	Mcon             string            `json:"mcon"`
+	IsImportant      bool              `json:"isImportant"`
+	ImportanceScore  float64           `json:"importanceScore"`
+	KeyAssetOverride *KeyAssetOverride `json:"keyAssetOverride"`
+}
+
+type GetTable struct {
+	GetTable *Table `json:"getTable"`
+}
+
+const GetTableQuery string = "query getTable($mcon: String!) { getTable(mcon: $mcon) { mcon,isImportant,importanceScore,keyAssetOverride{isKeyAsset,reason} } }"
+
+type SetKeyAssetOverride struct {
+	SetKeyAssetOverride struct {
+		Success bool
+	} `graphql:"setKeyAssetOverride(mcon: $mcon, isKeyAsset: $isKeyAsset, reason: $reason)"`
+}
+
+type DeleteKeyAssetOverride struct {
+	DeleteKeyAssetOverride struct {
+		Success bool
+	} `graphql:"deleteKeyAssetOverride(mcon: $mcon)"`
+}
+
+type BlackoutWindowScheduleInput struct {
+	Cron            string `json:"cron"`
+	DurationMinutes int64  `json:"durationMinutes"`
+	Timezone        string `json:"timezone"`
+}
+
+type BlackoutWindowScopeInput struct {
+	WarehouseUuids []UUID   `json:"warehouseUuids"`
+	DomainUuids    []UUID   `json:"domainUuids"`
+	Mcons          []string `json:"mcons"`
+	MonitorUuids   []UUID   `json:"monitorUuids"`
+}
+
+type BlackoutWindow struct {
+	Uuid      string  `json:"uuid"`
+	Reason    string  `json:"reason"`
+	StartTime *string `json:"startTime"`
+	EndTime   *string `json:"endTime"`
+	Schedule  *struct {
+		Cron            string `json:"cron"`
+		DurationMinutes int64  `json:"durationMinutes"`
+		Timezone        string `json:"timezone"`
+	} `json:"schedule"`
+	Scope struct {
+		WarehouseUuids []string `json:"warehouseUuids"`
+		DomainUuids    []string `json:"domainUuids"`
+		Mcons          []string `json:"mcons"`
+		MonitorUuids   []string `json:"monitorUuids"`
+	} `json:"scope"`
+}
+
+type CreateOrUpdateBlackoutWindow struct {
+	CreateOrUpdateBlackoutWindow struct {
+		BlackoutWindow struct {
+			Uuid string
+		}
+	} `graphql:"createOrUpdateBlackoutWindow(uuid: $uuid, reason: $reason, startTime: $startTime, endTime: $endTime, schedule: $schedule, scope: $scope)"`
+}
+
+type GetBlackoutWindow struct {
+	GetBlackoutWindow *BlackoutWindow `json:"getBlackoutWindow"`
+}
+
+const GetBlackoutWindowQuery string = "query getBlackoutWindow($uuid: UUID!) { getBlackoutWindow(uuid: $uuid) { uuid,reason,startTime,endTime,schedule{cron,durationMinutes,timezone},scope{warehouseUuids,domainUuids,mcons,monitorUuids} } }"
+
+type DeleteBlackoutWindow struct {
+	DeleteBlackoutWindow struct {
+		Success bool
+	} `graphql:"deleteBlackoutWindow(uuid: $uuid)"`
+}
+
+type TriggerCircuitBreakerRule struct {
+	TriggerCircuitBreakerRule struct {
+		JobExecutionUuid string
+	} `graphql:"triggerCircuitBreakerRule(ruleUuid: $ruleUuid)"`
+}
+
+type GetCircuitBreakerRuleState struct {
+	GetCircuitBreakerRuleState struct {
+		JobExecutionUuid string
+		RuleUuid         string
+		Status           string
+		Log              string
+	} `graphql:"getCircuitBreakerRuleState(jobExecutionUuid: $jobExecutionUuid)"`
+}
+
+type CircuitBreakerLogEntry struct {
+	Stage   string `json:"stage"`
+	Payload struct {
+		BreachCount *int64 `json:"breach_count"`
+		Error       string `json:"error"`
+	} `json:"payload"`
+}
+
+type DataProduct struct {
+	Uuid        string                  `json:"uuid"`
+	Name        string                  `json:"name"`
+	Description string                  `json:"description"`
+	DomainUuid  *string                 `json:"domainUuid"`
+	Tags        []TagKeyValuePairOutput `json:"tags"`
+	Assets      []string                `json:"assets"`
+}
+
+type CreateOrUpdateDataProduct struct {
+	CreateOrUpdateDataProduct struct {
+		DataProduct struct {
+			Uuid string
+		}
+	} `graphql:"createOrUpdateDataProduct(uuid: $uuid, name: $name, description: $description, domainUuid: $domainUuid, tags: $tags, assets: $assets)"`
+}
+
+type GetDataProduct struct {
+	GetDataProduct *DataProduct `json:"getDataProduct"`
+}
+
+const GetDataProductQuery string = "query getDataProduct($uuid: UUID!) { getDataProduct(uuid: $uuid) { uuid,name,description,domainUuid,tags{name,value},assets } }"
+
+type DeleteDataProduct struct {
+	DeleteDataProduct struct {
+		Success bool
+	} `graphql:"deleteDataProduct(uuid: $uuid)"`
+}
@@ -0,0 +1,4 @@
+data "montecarlo_circuit_breaker" "example" {
+  rule_uuid       = "2d2e9a2b-6f3d-4c1e-9b1a-2c5e0a7e3f41"
+  timeout_seconds = 600
+}
@@ -0,0 +1,13 @@
+data "montecarlo_domain" "by_name" {
+  name = "finance"
+}
+
+data "montecarlo_domain" "by_uuid" {
+  uuid = "ba0c4080-089d-4377-8878-466c31d19807"
+}
+
+resource "montecarlo_iam_group" "example" {
+  name    = "finance-viewers"
+  role    = "mcd/viewer"
+  domains = [data.montecarlo_domain.by_name.uuid]
+}
@@ -0,0 +1,5 @@
+data "montecarlo_domains" "all" {}
+
+output "domain_uuids" {
+  value = { for domain in data.montecarlo_domains.all.domains : domain.name => domain.uuid }
+}
@@ -0,0 +1,5 @@
+data "montecarlo_iam_groups" "all" {}
+
+output "group_members" {
+  value = { for group in data.montecarlo_iam_groups.all.groups : group.id => group.members }
+}
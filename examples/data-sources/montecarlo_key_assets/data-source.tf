@@ -0,0 +1,3 @@
+data "montecarlo_key_assets" "example" {
+  warehouse_uuid = "uuid"
+}
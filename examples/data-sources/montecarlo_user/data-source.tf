@@ -0,0 +1,8 @@
+data "montecarlo_user" "example" {
+  email = "user@google.com"
+}
+
+resource "montecarlo_iam_member" "example" {
+  group  = "groups/custom-group"
+  member = data.montecarlo_user.example.member
+}
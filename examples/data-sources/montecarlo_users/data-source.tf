@@ -0,0 +1,5 @@
+data "montecarlo_users" "all" {}
+
+output "sso_users" {
+  value = [for user in data.montecarlo_users.all.users : user.email if user.is_sso]
+}
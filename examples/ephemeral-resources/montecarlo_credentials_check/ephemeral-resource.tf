@@ -0,0 +1,18 @@
+ephemeral "montecarlo_credentials_check" "bigquery" {
+  bigquery = {
+    collector_uuid      = "dataCollectorUUID"
+    service_account_key = var.bq_service_account
+  }
+  fail_on_error = true # failed check fails terraform plan
+}
+
+ephemeral "montecarlo_credentials_check" "postgres" {
+  transactional = {
+    db_type  = "POSTGRES"
+    host     = "host"
+    port     = 5432
+    database = "database"
+    username = var.pg_user
+    password = var.pg_password
+  }
+}
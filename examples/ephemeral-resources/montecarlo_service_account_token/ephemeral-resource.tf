@@ -0,0 +1,13 @@
+ephemeral "montecarlo_service_account_token" "ci" {
+  description        = "CI job token"
+  groups             = ["groups/viewers-all"]
+  expiration_in_days = 1
+}
+
+provider "montecarlo" {
+  alias = "ci"
+  account_service_key = {
+    id    = ephemeral.montecarlo_service_account_token.ci.id
+    token = ephemeral.montecarlo_service_account_token.ci.token
+  }
+}
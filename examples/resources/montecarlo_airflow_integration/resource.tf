@@ -0,0 +1,11 @@
+resource "montecarlo_airflow_integration" "example" {
+  warehouse_uuid      = montecarlo_bigquery_warehouse.example.uuid
+  collector_uuid      = "uuid"
+  deletion_protection = false
+
+  credentials = {
+    host_name = "https://airflow.company.com"
+    username  = "username"  #(secret)
+    password  = "password"  #(secret)
+  }
+}
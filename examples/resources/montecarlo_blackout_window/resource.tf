@@ -0,0 +1,20 @@
+resource "montecarlo_blackout_window" "example" {
+  reason     = "Warehouse migration"
+  start_time = "2025-03-01T00:00:00Z"
+  end_time   = "2025-03-01T06:00:00Z"
+  scope = {
+    warehouses = [montecarlo_bigquery_warehouse.example.uuid]
+  }
+}
+
+resource "montecarlo_blackout_window" "example_recurring" {
+  reason = "Nightly backfill"
+  schedule = {
+    cron             = "0 1 * * *"
+    duration_minutes = 90
+    timezone         = "Europe/Prague"
+  }
+  scope = {
+    tables = ["MCON++a84380ed-b962-4bd3-b150-04bc38a209d5++427a1600-2653-40c5-a1e7-5ec98703ee9d++table++gcp-project1-722af1c6:dataset.table"]
+  }
+}
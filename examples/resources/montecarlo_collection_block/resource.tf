@@ -0,0 +1,14 @@
+resource "montecarlo_collection_block" "example" {
+  warehouse_uuid = montecarlo_bigquery_warehouse.example.uuid
+  mode           = "EXCLUDE"
+  project        = "gcp-project1-722af1c6"
+  dataset        = "analytics_scratch"
+}
+
+resource "montecarlo_collection_block" "example_tables" {
+  warehouse_uuid   = montecarlo_bigquery_warehouse.example.uuid
+  project          = "gcp-project1-722af1c6"
+  dataset          = "finance"
+  table_pattern    = "^tmp_.*"
+  collection_types = ["QUERY_LOGS"]
+}
@@ -0,0 +1,15 @@
+resource "montecarlo_data_product" "example" {
+  name        = "Finance reporting"
+  description = "Tables backing the monthly finance reports"
+  domain_uuid = montecarlo_domain.example.uuid
+  assets = [
+    "MCON++a84380ed-b962-4bd3-b150-04bc38a209d5++427a1600-2653-40c5-a1e7-5ec98703ee9d++table++gcp-project1-722af1c6:finance.revenue",
+    "MCON++a84380ed-b962-4bd3-b150-04bc38a209d5++427a1600-2653-40c5-a1e7-5ec98703ee9d++table++gcp-project1-722af1c6:finance.costs"
+  ]
+  tags = [
+    {
+      name  = "owner"
+      value = "finance"
+    }
+  ]
+}
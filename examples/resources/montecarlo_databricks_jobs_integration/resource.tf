@@ -0,0 +1,11 @@
+resource "montecarlo_databricks_jobs_integration" "example" {
+  warehouse_uuid      = montecarlo_bigquery_warehouse.example.uuid
+  collector_uuid      = "uuid"
+  deletion_protection = false
+
+  credentials = {
+    workspace_url = "https://dbc-12345678-abcd.cloud.databricks.com"
+    workspace_id  = "1234567890123456"
+    token         = "token"  #(secret)
+  }
+}
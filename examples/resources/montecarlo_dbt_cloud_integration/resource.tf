@@ -0,0 +1,12 @@
+resource "montecarlo_dbt_cloud_integration" "example" {
+  warehouse_uuid      = montecarlo_bigquery_warehouse.example.uuid
+  webhook_enabled     = true
+  project_ids         = ["12345"]
+  job_ids             = []
+  deletion_protection = false
+
+  credentials = {
+    account_id    = "account_id"
+    service_token = "service_token"  #(secret)
+  }
+}
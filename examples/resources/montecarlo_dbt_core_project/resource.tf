@@ -0,0 +1,4 @@
+resource "montecarlo_dbt_core_project" "example" {
+  project_name   = "analytics"
+  warehouse_uuid = montecarlo_bigquery_warehouse.example.uuid
+}
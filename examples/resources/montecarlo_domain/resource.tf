@@ -38,3 +38,19 @@ resource "montecarlo_domain" "example_assignments_advanced" {
     data.montecarlo_warehouse.bq.projects["gcp-project2-744bc2c5"].datasets["postgre-dataset-2"].tables["table-1"].mcon,
   ]
 }
+
+resource "montecarlo_domain" "example_rules" {
+  name        = "finance"
+  description = "description"
+  rules = [
+    {
+      operator    = "AND"
+      tags        = [{ name = "owner", value = "finance" }]
+      assignments = ["MCON++a84380ed-b962-4bd3-b150-04bc38a209d5++427a1600-2653-40c5-a1e7-5ec98703ee9d++dataset++gcp-project1-722af1c6:finance"]
+      exclusions  = ["MCON++a84380ed-b962-4bd3-b150-04bc38a209d5++427a1600-2653-40c5-a1e7-5ec98703ee9d++table++gcp-project1-722af1c6:finance.scratch"]
+    },
+    {
+      assignments = ["MCON++a84380ed-b962-4bd3-b150-04bc38a209d5++427a1600-2653-40c5-a1e7-5ec98703ee9d++dataset++gcp-project2-744bc2c5:billing"]
+    }
+  ]
+}
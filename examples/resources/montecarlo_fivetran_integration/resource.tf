@@ -0,0 +1,10 @@
+resource "montecarlo_fivetran_integration" "example" {
+  warehouse_uuid      = montecarlo_bigquery_warehouse.example.uuid
+  collector_uuid      = "uuid"
+  deletion_protection = false
+
+  credentials = {
+    api_key    = "api_key"  #(secret)
+    api_secret = "api_secret"  #(secret)
+  }
+}
@@ -0,0 +1,12 @@
+resource "montecarlo_iam_group" "example" {
+  name = "data-engineers"
+  role = "mcd/editor"
+}
+
+resource "montecarlo_iam_group_members" "example" {
+  group = "groups/${montecarlo_iam_group.example.name}"
+  members = [
+    "user:user1@google.com",
+    "user:user2@google.com",
+  ]
+}
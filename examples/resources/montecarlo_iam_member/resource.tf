@@ -16,3 +16,14 @@ resource "montecarlo_iam_member" "example_multiple" {
     "user:user2@google.com"
   ])
 }
+
+resource "montecarlo_iam_member" "example_service_account" {
+  group = "groups/custom-group"
+  member = "serviceAccount:${montecarlo_service_account.example.id}"
+}
+
+resource "montecarlo_iam_member" "example_read_only" {
+  group = "groups/sso-group"
+  member = "user:user@google.com"
+  read_only = true
+}
@@ -0,0 +1,5 @@
+resource "montecarlo_iam_role" "example" {
+  name        = "monitors-editor"
+  description = "Can view and edit monitors"
+  permissions = ["Monitors/View", "Monitors/Edit"]
+}
@@ -0,0 +1,5 @@
+resource "montecarlo_key_asset" "example" {
+  mcon      = "MCON++a84380ed-b962-4bd3-b150-04bc38a209d5++427a1600-2653-40c5-a1e7-5ec98703ee9d++table++gcp-project1-722af1c6:finance.orders"
+  key_asset = true
+  reason    = "Source of the revenue reporting"
+}
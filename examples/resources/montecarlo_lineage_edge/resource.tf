@@ -0,0 +1,5 @@
+resource "montecarlo_lineage_edge" "example" {
+  source_mcon      = montecarlo_lineage_node.example.mcon
+  destination_mcon = "MCON++a84380ed-b962-4bd3-b150-04bc38a209d5++427a1600-2653-40c5-a1e7-5ec98703ee9d++table++gcp-project1-722af1c6:finance.orders"
+  expire_at        = "2099-12-31T00:00:00Z"
+}
@@ -0,0 +1,9 @@
+resource "montecarlo_lineage_node" "example" {
+  object_type   = "kafka-topic"
+  object_id     = "orders"
+  name          = "orders"
+  resource_uuid = montecarlo_bigquery_warehouse.example.uuid
+  properties = {
+    owner = "data-platform"
+  }
+}
@@ -0,0 +1,11 @@
+resource "montecarlo_looker_integration" "example" {
+  name                = "name"
+  collector_uuid      = "uuid"
+  deletion_protection = false
+
+  credentials = {
+    base_url      = "https://company.looker.com"
+    client_id     = "client_id"      #(secret)
+    client_secret = "client_secret"  #(secret)
+  }
+}
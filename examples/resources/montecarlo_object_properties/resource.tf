@@ -0,0 +1,11 @@
+resource "montecarlo_object_properties" "example" {
+  mcons = [
+    "MCON++a84380ed-b962-4bd3-b150-04bc38a209d5++427a1600-2653-40c5-a1e7-5ec98703ee9d++table++gcp-project1-722af1c6:finance.orders",
+    "MCON++a84380ed-b962-4bd3-b150-04bc38a209d5++427a1600-2653-40c5-a1e7-5ec98703ee9d++table++gcp-project1-722af1c6:finance.payments",
+  ]
+  authoritative = false
+  tags = [
+    { name = "owner", value = "finance" },
+    { name = "pii" }
+  ]
+}
@@ -0,0 +1,11 @@
+resource "montecarlo_power_bi_integration" "example" {
+  name                = "name"
+  collector_uuid      = "uuid"
+  deletion_protection = false
+
+  credentials = {
+    tenant_id     = "tenant_id"
+    client_id     = "client_id"      #(secret)
+    client_secret = "client_secret"  #(secret)
+  }
+}
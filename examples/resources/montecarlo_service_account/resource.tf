@@ -0,0 +1,7 @@
+resource "montecarlo_service_account" "example" {
+  description        = "Airflow integration"
+  display_name       = "airflow"
+  groups             = ["groups/editors-all"]
+  expiration_in_days = 90
+  rotate_after_days  = 60 # new token is created before the old one is deleted
+}
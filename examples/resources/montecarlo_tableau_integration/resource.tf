@@ -0,0 +1,12 @@
+resource "montecarlo_tableau_integration" "example" {
+  name                = "name"
+  collector_uuid      = "uuid"
+  deletion_protection = false
+
+  credentials = {
+    server_name = "https://tableau.company.com"
+    site_name   = "site"
+    token_name  = "token_name"   #(secret)
+    token_value = "token_value"  #(secret)
+  }
+}
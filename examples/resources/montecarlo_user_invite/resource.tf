@@ -0,0 +1,4 @@
+resource "montecarlo_user_invite" "example" {
+  email  = "user@google.com"
+  groups = ["groups/custom-group"]
+}
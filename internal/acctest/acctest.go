@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -18,6 +19,13 @@ var TestAccProviderFactories = map[string]func() (tfprotov6.ProviderServer, erro
 	"montecarlo": providerserver.NewProtocol6WithError(internal.New("test")()),
 }
 
+// TestAccEphemeralProviderFactories additionally include 'echo' provider, which is used
+// to expose results of the ephemeral resources to the test checks.
+var TestAccEphemeralProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"montecarlo": providerserver.NewProtocol6WithError(internal.New("test")()),
+	"echo":       echoprovider.NewProviderServer(),
+}
+
 func TestAccPreCheck(t *testing.T) {
 	if v := os.Getenv("MC_API_KEY_ID"); v == "" {
 		t.Fatalf("'MC_API_KEY_ID' must be set for acceptance tests")
@@ -7,13 +7,16 @@ import (
 	"github.com/kiwicom/terraform-provider-montecarlo/client"
 	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
 
-	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -23,6 +26,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &IamGroupResource{}
 var _ resource.ResourceWithImportState = &IamGroupResource{}
+var _ resource.ResourceWithConfigValidators = &IamGroupResource{}
+var _ resource.ResourceWithModifyPlan = &IamGroupResource{}
 
 // To simplify provider implementations, a named function can be created with the resource implementation.
 func NewIamGroupResource() resource.Resource {
@@ -40,6 +45,7 @@ type IamGroupResourceModel struct {
 	Label       types.String   `tfsdk:"label"`
 	Description types.String   `tfsdk:"description"`
 	Role        types.String   `tfsdk:"role"`
+	Roles       types.Set      `tfsdk:"roles"`
 	Domains     []types.String `tfsdk:"domains"`
 	SsoGroup    types.String   `tfsdk:"sso_group"`
 }
@@ -70,17 +76,18 @@ func (r *IamGroupResource) Schema(ctx context.Context, req resource.SchemaReques
 				Default:  stringdefault.StaticString(""),
 			},
 			"role": schema.StringAttribute{
-				Required: true,
-				Validators: []validator.String{
-					stringvalidator.OneOf(
-						"mcd/owner",
-						"mcd/domains-manager",
-						"mcd/responder",
-						"mcd/editor",
-						"mcd/viewer",
-						"mcd/asset-viewer",
-						"mcd/asset-editor",
-					),
+				Optional:           true,
+				DeprecationMessage: "Use 'roles' attribute instead, 'role' attribute will be removed in the next major version.",
+			},
+			"roles": schema.SetAttribute{
+				Computed:    true,
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
 				},
 			},
 			"domains": schema.SetAttribute{
@@ -101,6 +108,33 @@ func (r *IamGroupResource) Schema(ctx context.Context, req resource.SchemaReques
 	}
 }
 
+func (r *IamGroupResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("role"),
+			path.MatchRoot("roles"),
+		),
+	}
+}
+
+// ModifyPlan plans roles derived from the deprecated 'role' attribute, so that change of the
+// role is not hidden by the roles kept from the state.
+func (r *IamGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return // nothing to plan during deletion
+	}
+
+	var role types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("role"), &role)...)
+	if resp.Diagnostics.HasError() || role.IsNull() {
+		return
+	} else if role.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("roles"), types.SetUnknown(types.StringType))...)
+	} else {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("roles"), []string{role.ValueString()})...)
+	}
+}
+
 func (r *IamGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	client, diags := common.Configure(req)
 	resp.Diagnostics.Append(diags...)
@@ -114,18 +148,27 @@ func (r *IamGroupResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	roles := data.roles()
+	resp.Diagnostics.Append(validateRoles(ctx, r.client, data.rolesPath(), roles)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createResult := client.CreateOrUpdateAuthorizationGroup{}
 	variables := map[string]interface{}{
 		"name":                 data.Name.ValueString(),
 		"label":                data.Name.ValueString(),
 		"description":          data.Description.ValueString(),
-		"roles":                []string{data.Role.ValueString()},
+		"roles":                roles,
 		"domainRestrictionIds": common.TfStringsTo[client.UUID](data.Domains),
 		"ssoGroup":             data.SsoGroup.ValueStringPointer(),
 	}
 
 	if err := r.client.Mutate(ctx, &createResult, variables); err == nil {
 		data.Label = types.StringValue(data.Name.ValueString())
+		var diags diag.Diagnostics
+		data.Roles, diags = types.SetValueFrom(ctx, types.StringType, roles)
+		resp.Diagnostics.Append(diags...)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	} else {
 		to_print := fmt.Sprintf("MC client 'createOrUpdateAuthorizationGroup' mutation result - %s", err.Error())
@@ -164,7 +207,14 @@ func (r *IamGroupResource) Read(ctx context.Context, req resource.ReadRequest, r
 	} else {
 		data.Label = types.StringValue(found.Label)
 		data.Description = types.StringValue(found.Description)
-		data.Role = common.TfStringsFrom(rolesToNames(found.Roles))[0]
+		var diags diag.Diagnostics
+		data.Roles, diags = types.SetValueFrom(ctx, types.StringType, rolesToNames(found.Roles))
+		resp.Diagnostics.Append(diags...)
+		if !data.Role.IsNull() && len(found.Roles) == 1 {
+			data.Role = types.StringValue(found.Roles[0].Name)
+		} else if !data.Role.IsNull() {
+			data.Role = types.StringValue("") // single configured role no longer matches roles of the group
+		}
 		data.Domains = common.TfStringsFrom(domainsToUuids[string](found.DomainRestrictions))
 		data.SsoGroup = types.StringPointerValue(found.SsoGroup)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -178,17 +228,26 @@ func (r *IamGroupResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	roles := data.roles()
+	resp.Diagnostics.Append(validateRoles(ctx, r.client, data.rolesPath(), roles)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	updateResult := client.CreateOrUpdateAuthorizationGroup{}
 	variables := map[string]interface{}{
 		"name":                 data.Name.ValueString(),
 		"label":                data.Name.ValueString(),
 		"description":          data.Description.ValueString(),
-		"roles":                []string{data.Role.ValueString()},
+		"roles":                roles,
 		"domainRestrictionIds": common.TfStringsTo[client.UUID](data.Domains),
 		"ssoGroup":             data.SsoGroup.ValueStringPointer(),
 	}
 
 	if err := r.client.Mutate(ctx, &updateResult, variables); err == nil {
+		var diags diag.Diagnostics
+		data.Roles, diags = types.SetValueFrom(ctx, types.StringType, roles)
+		resp.Diagnostics.Append(diags...)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	} else {
 		to_print := fmt.Sprintf("MC client 'createOrUpdateAuthorizationGroup' mutation result - %s", err.Error())
@@ -222,6 +281,26 @@ func (r *IamGroupResource) ImportState(ctx context.Context, req resource.ImportS
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
 
+// roles returns configured roles of the group, either from deprecated 'role' attribute or from 'roles'.
+func (m IamGroupResourceModel) roles() []string {
+	if !m.Role.IsNull() {
+		return []string{m.Role.ValueString()}
+	}
+
+	roles := make([]string, 0, len(m.Roles.Elements()))
+	for _, role := range m.Roles.Elements() {
+		roles = append(roles, role.(types.String).ValueString())
+	}
+	return roles
+}
+
+func (m IamGroupResourceModel) rolesPath() path.Path {
+	if !m.Role.IsNull() {
+		return path.Root("role")
+	}
+	return path.Root("roles")
+}
+
 func rolesToNames(roles []struct{ Name string }) []string {
 	result := make([]string, len(roles))
 	for i, role := range roles {
@@ -0,0 +1,247 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IamGroupMembersResource{}
+var _ resource.ResourceWithImportState = &IamGroupMembersResource{}
+
+// To simplify provider implementations, a named function can be created with the resource implementation.
+func NewIamGroupMembersResource() resource.Resource {
+	return &IamGroupMembersResource{}
+}
+
+// IamGroupMembersResource defines the resource implementation. Unlike IamMemberResource,
+// this resource is authoritative - it owns the full list of the authorization group members.
+type IamGroupMembersResource struct {
+	client client.MonteCarloClient
+}
+
+// IamGroupMembersResourceModel describes the resource data model according to its Schema.
+type IamGroupMembersResourceModel struct {
+	Group   types.String   `tfsdk:"group"`
+	Members []types.String `tfsdk:"members"`
+}
+
+func (r *IamGroupMembersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_group_members"
+}
+
+func (r *IamGroupMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"group": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(groupsRegex, "Expected format - groups/{group_name}"),
+				},
+			},
+			"members": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(userMemberRegex, "Expected format - user:{user_email}"),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *IamGroupMembersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *IamGroupMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IamGroupMembersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, data)...)
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+func (r *IamGroupMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IamGroupMembersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupName := strings.Split(data.Group.ValueString(), "groups/")[1]
+	group, diags := getAuthorizationGroup(ctx, r.client, groupName)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	} else if group == nil || isSsoManaged(group) {
+		to_print := fmt.Sprintf("Group %s not found or is SSO managed. "+
+			"This resource will be removed from the Terraform state without deletion.", data.Group.ValueString())
+		resp.Diagnostics.AddWarning(to_print, "")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// keeping the configured letter case of emails, since Monte Carlo emails are case insensitive
+	configured := map[string]types.String{}
+	for _, member := range data.Members {
+		configured[strings.ToLower(member.ValueString())] = member
+	}
+
+	data.Members = make([]types.String, 0, len(group.Users))
+	for _, user := range group.Users {
+		member := "user:" + user.Email
+		if configuredMember, ok := configured[strings.ToLower(member)]; ok {
+			data.Members = append(data.Members, configuredMember)
+		} else {
+			data.Members = append(data.Members, types.StringValue(member))
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IamGroupMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data IamGroupMembersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, data)...)
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+func (r *IamGroupMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IamGroupMembersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Members = []types.String{}
+	resp.Diagnostics.Append(r.reconcile(ctx, data)...)
+}
+
+func (r *IamGroupMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if groupsRegex.MatchString(req.ID) {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group"), req.ID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("members"), []string{})...)
+	} else {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf(
+			"Expected import identifier with format: groups/<group_name>. Got: %q", req.ID),
+		)
+	}
+}
+
+// reconcile computes which users must be added to or removed from the group, so that group members
+// match configured members exactly. All of the configured users are looked up before any change
+// is made and unknown users are reported in a single diagnostic.
+func (r *IamGroupMembersResource) reconcile(ctx context.Context, data IamGroupMembersResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	groupName := strings.Split(data.Group.ValueString(), "groups/")[1]
+	group, groupDiags := getAuthorizationGroup(ctx, r.client, groupName)
+	diags.Append(groupDiags...)
+	if diags.HasError() {
+		return diags
+	} else if group == nil || isSsoManaged(group) {
+		to_print := fmt.Sprintf("Group %s not found or is SSO managed", data.Group.ValueString())
+		diags.AddError(to_print, "")
+		return diags
+	}
+
+	current := map[string]struct{}{}
+	for _, user := range group.Users {
+		current[strings.ToLower(user.Email)] = struct{}{}
+	}
+
+	desired := map[string]struct{}{}
+	toAdd, unknown := []string{}, []string{}
+	for _, member := range data.Members {
+		email := strings.ToLower(strings.Split(member.ValueString(), "user:")[1])
+		desired[email] = struct{}{}
+		if _, ok := current[email]; ok {
+			continue
+		} else if user, userDiags := getUserByEmail(ctx, r.client, email); userDiags.HasError() {
+			diags.Append(userDiags...)
+			return diags
+		} else if user == nil {
+			unknown = append(unknown, email)
+		} else {
+			toAdd = append(toAdd, email)
+		}
+	}
+
+	if len(unknown) > 0 {
+		slices.Sort(unknown)
+		diags.AddAttributeError(path.Root("members"),
+			fmt.Sprintf("Users [%d] not found", len(unknown)),
+			fmt.Sprintf("Following users were not found in Monte Carlo (users must log in or be invited first):\n  - %s",
+				strings.Join(unknown, "\n  - ")))
+		return diags
+	}
+
+	toRemove := []string{}
+	for email := range current {
+		if _, ok := desired[email]; !ok {
+			toRemove = append(toRemove, email)
+		}
+	}
+
+	slices.Sort(toAdd)
+	slices.Sort(toRemove)
+	for _, email := range toAdd {
+		user, updateDiags := modifyUserGroups(ctx, r.client, email, addGroup(group.Name))
+		diags.Append(updateDiags...)
+		if !updateDiags.HasError() && user == nil {
+			diags.Append(userDeletedDiag(email)...)
+		}
+	}
+	for _, email := range toRemove {
+		user, updateDiags := modifyUserGroups(ctx, r.client, email, removeGroup(group.Name))
+		diags.Append(updateDiags...)
+		if !updateDiags.HasError() && user == nil {
+			diags.Append(userDeletedDiag(email)...)
+		}
+	}
+	return diags
+}
+
+// userDeletedDiag reports user which was found during the reconciliation, but no longer exists
+// when its groups are modified (deleted in between), therefore the membership change was not applied.
+func userDeletedDiag(email string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.AddAttributeError(path.Root("members"), fmt.Sprintf("User %q not found", email),
+		fmt.Sprintf("User %q was not found in Monte Carlo while its groups were modified (user was probably deleted "+
+			"in the meantime), therefore its membership was not changed.", email))
+	return diags
+}
@@ -0,0 +1,69 @@
+package authorization_test
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIamGroupMembersResource(t *testing.T) {
+	mc_api_key_id := os.Getenv("MC_API_KEY_ID")
+	mc_api_key_token := os.Getenv("MC_API_KEY_TOKEN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{ // Create and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("create.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_iam_group_members.test", "group", "groups/TestAccIamGroupMembersResource"),
+					resource.TestCheckResourceAttr("montecarlo_iam_group_members.test", "members.#", "1"),
+					resource.TestCheckTypeSetElemAttr("montecarlo_iam_group_members.test", "members.*", "user:ndopjera@gmail.com"),
+				),
+			},
+			{ // ImportState testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				ResourceName:                         "montecarlo_iam_group_members.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "groups/TestAccIamGroupMembersResource",
+				ImportStateVerifyIdentifierAttribute: "group",
+			},
+			{ // Unknown users are reported together, without any change
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("unknown_members.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				ExpectError: regexp.MustCompile(`Users \[2\] not found`),
+			},
+			{ // Update and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("update.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_iam_group_members.test", "group", "groups/TestAccIamGroupMembersResource"),
+					resource.TestCheckResourceAttr("montecarlo_iam_group_members.test", "members.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -30,6 +30,8 @@ func TestAccIamGroupResource(t *testing.T) {
 					resource.TestCheckResourceAttr("montecarlo_iam_group.test", "label", "group-1"),
 					resource.TestCheckResourceAttr("montecarlo_iam_group.test", "description", ""),
 					resource.TestCheckResourceAttr("montecarlo_iam_group.test", "role", "mcd/editor"),
+					resource.TestCheckResourceAttr("montecarlo_iam_group.test", "roles.#", "1"),
+					resource.TestCheckTypeSetElemAttr("montecarlo_iam_group.test", "roles.*", "mcd/editor"),
 					resource.TestCheckResourceAttr("montecarlo_iam_group.test", "domains.#", "0"),
 					resource.TestCheckNoResourceAttr("montecarlo_iam_group.test", "ssoGroup"),
 				),
@@ -47,6 +49,7 @@ func TestAccIamGroupResource(t *testing.T) {
 					return s.RootModule().Resources["montecarlo_iam_group.test"].Primary.Attributes["name"], nil
 				},
 				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"role"}, // deprecated, imported as 'roles'
 			},
 			{ // Update and Read testing
 				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
@@ -59,7 +62,10 @@ func TestAccIamGroupResource(t *testing.T) {
 					resource.TestCheckResourceAttr("montecarlo_iam_group.test", "name", "group-1"),
 					resource.TestCheckResourceAttr("montecarlo_iam_group.test", "label", "group-1"),
 					resource.TestCheckResourceAttr("montecarlo_iam_group.test", "description", ""),
-					resource.TestCheckResourceAttr("montecarlo_iam_group.test", "role", "mcd/viewer"),
+					resource.TestCheckNoResourceAttr("montecarlo_iam_group.test", "role"),
+					resource.TestCheckResourceAttr("montecarlo_iam_group.test", "roles.#", "2"),
+					resource.TestCheckTypeSetElemAttr("montecarlo_iam_group.test", "roles.*", "mcd/viewer"),
+					resource.TestCheckTypeSetElemAttr("montecarlo_iam_group.test", "roles.*", "mcd/responder"),
 					resource.TestCheckResourceAttr("montecarlo_iam_group.test", "domains.#", "2"),
 					resource.TestCheckTypeSetElemAttr("montecarlo_iam_group.test", "domains.*", "ba0c4080-089d-4377-8878-466c31d19807"),
 					resource.TestCheckTypeSetElemAttr("montecarlo_iam_group.test", "domains.*", "dd4cda19-1c5c-4339-9628-76376c9e281e"),
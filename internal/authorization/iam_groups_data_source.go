@@ -0,0 +1,138 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &IamGroupsDataSource{}
+
+func NewIamGroupsDatasource() datasource.DataSource {
+	return &IamGroupsDataSource{}
+}
+
+type IamGroupsDataSource struct {
+	client client.MonteCarloClient
+}
+
+type IamGroupsDataSourceModel struct {
+	Groups []IamGroupDataSourceModel `tfsdk:"groups"`
+}
+
+type IamGroupDataSourceModel struct {
+	Id          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Label       types.String   `tfsdk:"label"`
+	Description types.String   `tfsdk:"description"`
+	IsManaged   types.Bool     `tfsdk:"is_managed"`
+	Roles       []types.String `tfsdk:"roles"`
+	Domains     []types.String `tfsdk:"domains"`
+	SsoGroup    types.String   `tfsdk:"sso_group"`
+	Members     []types.String `tfsdk:"members"`
+}
+
+func NewIamGroupDataSourceModel(in client.AuthorizationGroup) IamGroupDataSourceModel {
+	result := IamGroupDataSourceModel{
+		Id:          types.StringValue("groups/" + in.Name),
+		Name:        types.StringValue(in.Name),
+		Label:       types.StringValue(in.Label),
+		Description: types.StringValue(in.Description),
+		IsManaged:   types.BoolValue(in.IsManaged),
+		Roles:       make([]types.String, 0, len(in.Roles)),
+		Domains:     make([]types.String, 0, len(in.DomainRestrictions)),
+		SsoGroup:    types.StringPointerValue(in.SsoGroup),
+		Members:     make([]types.String, 0, len(in.Users)),
+	}
+	for _, role := range in.Roles {
+		result.Roles = append(result.Roles, types.StringValue(role.Name))
+	}
+	for _, domain := range in.DomainRestrictions {
+		result.Domains = append(result.Domains, types.StringValue(domain.Uuid))
+	}
+	for _, user := range in.Users {
+		result.Members = append(result.Members, types.StringValue("user:"+user.Email))
+	}
+	return result
+}
+
+func (d *IamGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_groups"
+}
+
+func (d *IamGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"groups": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"label": schema.StringAttribute{
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+						"is_managed": schema.BoolAttribute{
+							Computed: true,
+						},
+						"roles": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"domains": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"sso_group": schema.StringAttribute{
+							Computed: true,
+						},
+						"members": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IamGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	d.client = client
+}
+
+func (d *IamGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IamGroupsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getGroupsResult := client.GetAuthorizationGroups{}
+	if err := d.client.Query(ctx, &getGroupsResult, map[string]interface{}{}); err != nil {
+		to_print := fmt.Sprintf("MC client 'GetAuthorizationGroups' query result - %s", err.Error())
+		resp.Diagnostics.AddError(to_print, "")
+		return
+	}
+
+	data.Groups = make([]IamGroupDataSourceModel, 0, len(getGroupsResult.GetAuthorizationGroups))
+	for _, group := range getGroupsResult.GetAuthorizationGroups {
+		data.Groups = append(data.Groups, NewIamGroupDataSourceModel(group))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
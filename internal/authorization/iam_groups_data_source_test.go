@@ -0,0 +1,38 @@
+package authorization_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIamGroupsDataSource(t *testing.T) {
+	mc_api_key_id := os.Getenv("MC_API_KEY_ID")
+	mc_api_key_token := os.Getenv("MC_API_KEY_TOKEN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{ // Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("read.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.montecarlo_iam_groups.test", "groups.*", map[string]string{
+						"id":          "groups/TestAccIamGroupsDataSource",
+						"description": "Groups data source test description",
+						"is_managed":  "false",
+						"roles.0":     "mcd/viewer",
+					}),
+				),
+			},
+		},
+	})
+}
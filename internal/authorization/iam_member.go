@@ -11,9 +11,12 @@ import (
 	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -21,7 +24,8 @@ import (
 )
 
 var groupsRegex = regexp.MustCompile(`^groups/.+$`)
-var memberRegex = regexp.MustCompile(`^user:.+$`)
+var memberRegex = regexp.MustCompile(`^(user|serviceAccount):.+$`)
+var userMemberRegex = regexp.MustCompile(`^user:.+$`)
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &IamMemberResource{}
@@ -42,6 +46,7 @@ type IamMemberResourceModel struct {
 	Group    types.String `tfsdk:"group"`
 	Member   types.String `tfsdk:"member"`
 	MemberId types.String `tfsdk:"member_id"`
+	ReadOnly types.Bool   `tfsdk:"read_only"`
 }
 
 func (r *IamMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -66,7 +71,7 @@ func (r *IamMemberResource) Schema(ctx context.Context, req resource.SchemaReque
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 				Validators: []validator.String{
-					stringvalidator.RegexMatches(memberRegex, "Expected format - user:{user_email}"),
+					stringvalidator.RegexMatches(memberRegex, "Expected format - user:{user_email} or serviceAccount:{token_id}"),
 				},
 			},
 			"member_id": schema.StringAttribute{
@@ -76,6 +81,14 @@ func (r *IamMemberResource) Schema(ctx context.Context, req resource.SchemaReque
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"read_only": schema.BoolAttribute{
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
 		},
 	}
 }
@@ -93,58 +106,60 @@ func (r *IamMemberResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	userEmail := strings.Split(data.Member.ValueString(), "user:")[1]
-	getUserResult := client.GetUsersInAccount{}
-	variables := map[string]interface{}{
-		"email": userEmail,
-		"first": 1,
-		"after": (*string)(nil),
-	}
-
-	if err := r.client.Query(ctx, &getUserResult, variables); err != nil {
-		to_print := fmt.Sprintf("MC client 'getUsersInAccount' query result - %s", err.Error())
-		resp.Diagnostics.AddError(to_print, "")
+	groupName := strings.Split(data.Group.ValueString(), "groups/")[1]
+	if group, diags := getAuthorizationGroup(ctx, r.client, groupName); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
 		return
-	} else if len(getUserResult.GetUsersInAccount.Edges) == 0 {
-		to_print := fmt.Sprintf("User %s not found", userEmail)
+	} else if group == nil {
+		to_print := fmt.Sprintf("Group %s not found", data.Group.ValueString())
 		resp.Diagnostics.AddError(to_print, "")
 		return
-	}
-
-	getGroupResult := client.GetAuthorizationGroups{}
-	variables = map[string]interface{}{}
-	if err := r.client.Query(ctx, &getGroupResult, variables); err != nil {
-		to_print := fmt.Sprintf("MC client 'GetAuthorizationGroups' query result - %s", err.Error())
-		resp.Diagnostics.AddError(to_print, "")
+	} else if isSsoManaged(group) && !data.ReadOnly.ValueBool() {
+		to_print := fmt.Sprintf("Group %s is SSO managed", data.Group.ValueString())
+		resp.Diagnostics.AddError(to_print, "Memberships of SSO managed groups can be only reported using 'read_only = true'.")
 		return
 	}
 
-	var group *client.AuthorizationGroup
-	groupName := strings.Split(data.Group.ValueString(), "groups/")[1]
-	if index := slices.IndexFunc(getGroupResult.GetAuthorizationGroups, func(group client.AuthorizationGroup) bool {
-		return (group.SsoGroup == nil || *group.SsoGroup == "") && group.Name == groupName
-	}); index >= 0 {
-		group = &getGroupResult.GetAuthorizationGroups[index]
-	} else {
-		to_print := fmt.Sprintf("Group %s not found or is SSO managed", data.Group.ValueString())
-		resp.Diagnostics.AddError(to_print, "")
+	if data.ReadOnly.ValueBool() {
+		memberId, groups, diags := r.readMember(ctx, data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		} else if !slices.Contains(groups, groupName) {
+			to_print := fmt.Sprintf("%s is not a member of group %s", data.Member.ValueString(), data.Group.ValueString())
+			resp.Diagnostics.AddError(to_print, "Resources with 'read_only = true' only report existing memberships.")
+			return
+		}
+		data.MemberId = types.StringValue(memberId)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 		return
 	}
 
-	user := &getUserResult.GetUsersInAccount.Edges[0].Node
-	updateResult := client.UpdateUserAuthorizationGroupMembership{}
-	variables = map[string]interface{}{
-		"memberUserId": user.CognitoUserId,
-		"groupNames":   append(user.Auth.Groups, group.Name),
-	}
-
-	if err := r.client.Mutate(ctx, &updateResult, variables); err != nil {
-		to_print := fmt.Sprintf("MC client 'updateUserAuthorizationGroupMembership' mutation result - %s", err.Error())
-		resp.Diagnostics.AddError(to_print, "")
+	kind, id := parseMember(data.Member.ValueString())
+	if kind == "serviceAccount" {
+		token, diags := modifyServiceAccountGroups(ctx, r.client, id, addGroup(groupName))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		} else if token == nil {
+			to_print := fmt.Sprintf("Service account %s not found", id)
+			resp.Diagnostics.AddError(to_print, "")
+			return
+		}
+		data.MemberId = types.StringValue(token.Id)
 	} else {
+		user, diags := modifyUserGroups(ctx, r.client, id, addGroup(groupName))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		} else if user == nil {
+			to_print := fmt.Sprintf("User %s not found", id)
+			resp.Diagnostics.AddError(to_print, "")
+			return
+		}
 		data.MemberId = types.StringValue(user.CognitoUserId)
-		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *IamMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -152,58 +167,38 @@ func (r *IamMemberResource) Read(ctx context.Context, req resource.ReadRequest,
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
+	} else if data.ReadOnly.IsNull() {
+		data.ReadOnly = types.BoolValue(false) // imported resources
 	}
 
-	userEmail := strings.Split(data.Member.ValueString(), "user:")[1]
-	getUserResult := client.GetUsersInAccount{}
-	variables := map[string]interface{}{
-		"email": userEmail,
-		"first": 1,
-		"after": (*string)(nil),
-	}
-
-	var foundUser *client.User
-	if err := r.client.Query(ctx, &getUserResult, variables); err != nil {
-		to_print := fmt.Sprintf("MC client 'getUsersInAccount' query result - %s", err.Error())
-		resp.Diagnostics.AddError(to_print, "")
+	memberId, groups, diags := r.readMember(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
-	} else if len(getUserResult.GetUsersInAccount.Edges) >= 1 {
-		foundUser = &getUserResult.GetUsersInAccount.Edges[0].Node
-	} else {
-		to_print := fmt.Sprintf("User %s not found", userEmail)
+	} else if memberId == "" {
+		to_print := fmt.Sprintf("Member %s not found", data.Member.ValueString())
 		resp.Diagnostics.AddWarning(to_print, "")
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	getGroupResult := client.GetAuthorizationGroups{}
-	variables = map[string]interface{}{}
-	if err := r.client.Query(ctx, &getGroupResult, variables); err != nil {
-		to_print := fmt.Sprintf("MC client 'GetAuthorizationGroups' query result - %s", err.Error())
-		resp.Diagnostics.AddError(to_print, "")
-		return
-	}
-
-	var group *client.AuthorizationGroup
 	groupName := strings.Split(data.Group.ValueString(), "groups/")[1]
-	if index := slices.IndexFunc(getGroupResult.GetAuthorizationGroups, func(group client.AuthorizationGroup) bool {
-		return (group.SsoGroup == nil || *group.SsoGroup == "") && group.Name == groupName
-	}); index >= 0 {
-		group = &getGroupResult.GetAuthorizationGroups[index]
-	} else {
-		data.Group = types.StringNull()
+	if group, diags := getAuthorizationGroup(ctx, r.client, groupName); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	} else if group == nil || (isSsoManaged(group) && !data.ReadOnly.ValueBool()) {
 		to_print := fmt.Sprintf("Group %s not found or is SSO managed", data.Group.ValueString())
 		resp.Diagnostics.AddWarning(to_print, "")
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	if !slices.Contains(foundUser.Auth.Groups, group.Name) {
-		to_print := fmt.Sprintf("User %s is not a member of group %s", userEmail, data.Group.ValueString())
+	if !slices.Contains(groups, groupName) {
+		to_print := fmt.Sprintf("%s is not a member of group %s", data.Member.ValueString(), data.Group.ValueString())
 		resp.Diagnostics.AddWarning(to_print, "")
 		resp.State.RemoveResource(ctx)
 	} else {
-		data.MemberId = types.StringValue(getUserResult.GetUsersInAccount.Edges[0].Node.CognitoUserId)
+		data.MemberId = types.StringValue(memberId)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	}
 }
@@ -218,53 +213,59 @@ func (r *IamMemberResource) Update(ctx context.Context, req resource.UpdateReque
 func (r *IamMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data IamMemberResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
+	if resp.Diagnostics.HasError() || data.ReadOnly.ValueBool() {
+		return // read only memberships are only removed from the Terraform state
 	}
 
-	userEmail := strings.Split(data.Member.ValueString(), "user:")[1]
-	getUserResult := client.GetUsersInAccount{}
-	variables := map[string]interface{}{
-		"email": userEmail,
-		"first": 1,
-		"after": (*string)(nil),
-	}
-
-	var foundUser *client.User
-	if err := r.client.Query(ctx, &getUserResult, variables); err != nil {
-		to_print := fmt.Sprintf("MC client 'getUsersInAccount' query result - %s", err.Error())
-		resp.Diagnostics.AddError(to_print, "")
-		return
-	} else if len(getUserResult.GetUsersInAccount.Edges) >= 1 {
-		foundUser = &getUserResult.GetUsersInAccount.Edges[0].Node
+	groupName := strings.Split(data.Group.ValueString(), "groups/")[1]
+	if kind, id := parseMember(data.Member.ValueString()); kind == "serviceAccount" {
+		token, diags := modifyServiceAccountGroups(ctx, r.client, id, removeGroup(groupName))
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() && token == nil {
+			to_print := fmt.Sprintf("Service account %s not found", id)
+			resp.Diagnostics.AddWarning(to_print, "")
+		}
 	} else {
-		to_print := fmt.Sprintf("User %s not found", userEmail)
-		resp.Diagnostics.AddWarning(to_print, "")
-		return
-	}
-
-	updateResult := client.UpdateUserAuthorizationGroupMembership{}
-	variables = map[string]interface{}{
-		"memberUserId": foundUser.CognitoUserId,
-		"groupNames": slices.DeleteFunc(foundUser.Auth.Groups, func(groupName string) bool {
-			return groupName == strings.Split(data.Group.ValueString(), "groups/")[1]
-		}),
-	}
-
-	if err := r.client.Mutate(ctx, &updateResult, variables); err != nil {
-		to_print := fmt.Sprintf("MC client 'updateUserAuthorizationGroupMembership' mutation result - %s", err.Error())
-		resp.Diagnostics.AddError(to_print, "")
+		user, diags := modifyUserGroups(ctx, r.client, id, removeGroup(groupName))
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() && user == nil {
+			to_print := fmt.Sprintf("User %s not found", id)
+			resp.Diagnostics.AddWarning(to_print, "")
+		}
 	}
 }
 
 func (r *IamMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	idsImported := strings.Split(req.ID, ",")
-	if len(idsImported) == 2 && idsImported[0] != "" && idsImported[1] != "" {
+	if len(idsImported) == 2 && groupsRegex.MatchString(idsImported[0]) && memberRegex.MatchString(idsImported[1]) {
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group"), idsImported[0])...)
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member"), idsImported[1])...)
 	} else {
 		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf(
-			"Expected import identifier with format: groups/<group_name>,user:<user_email>. Got: %q", req.ID),
+			"Expected import identifier with format: groups/<group_name>,user:<user_email> "+
+				"or groups/<group_name>,serviceAccount:<token_id>. Got: %q", req.ID),
 		)
 	}
 }
+
+// readMember returns ID of the configured member (user or service account) together with its groups.
+// Empty ID is returned if the member was not found.
+func (r *IamMemberResource) readMember(ctx context.Context, data IamMemberResourceModel) (string, []string, diag.Diagnostics) {
+	if kind, id := parseMember(data.Member.ValueString()); kind == "serviceAccount" {
+		if token, diags := getServiceAccount(ctx, r.client, id); diags.HasError() || token == nil {
+			return "", nil, diags
+		} else {
+			return token.Id, token.Groups, diags
+		}
+	} else if user, diags := getUserByEmail(ctx, r.client, id); diags.HasError() || user == nil {
+		return "", nil, diags
+	} else {
+		return user.CognitoUserId, user.Auth.Groups, diags
+	}
+}
+
+// parseMember splits member in format {kind}:{id} (e.g. user:{user_email}) into its parts.
+func parseMember(member string) (string, string) {
+	kind, id, _ := strings.Cut(member, ":")
+	return kind, id
+}
@@ -55,6 +55,20 @@ func TestAccIamMemberResource(t *testing.T) {
 					resource.TestCheckResourceAttr("montecarlo_iam_member.test", "member_id", "21ddb883-7586-4034-9767-e5f966ec10df"),
 				),
 			},
+			{ // Service account and read only memberships testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("service_account.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_iam_member.service_account", "group", "groups/TestAccIamMemberResource2"),
+					resource.TestCheckResourceAttrPair("montecarlo_iam_member.service_account", "member_id", "montecarlo_service_account.test", "id"),
+					resource.TestCheckResourceAttr("montecarlo_iam_member.read_only", "read_only", "true"),
+					resource.TestCheckResourceAttr("montecarlo_iam_member.read_only", "member_id", "21ddb883-7586-4034-9767-e5f966ec10df"),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,227 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IamRoleResource{}
+var _ resource.ResourceWithImportState = &IamRoleResource{}
+
+// To simplify provider implementations, a named function can be created with the resource implementation.
+func NewIamRoleResource() resource.Resource {
+	return &IamRoleResource{}
+}
+
+// IamRoleResource defines the resource implementation.
+type IamRoleResource struct {
+	client client.MonteCarloClient
+}
+
+// IamRoleResourceModel describes the resource data model according to its Schema.
+type IamRoleResourceModel struct {
+	Name        types.String   `tfsdk:"name"`
+	Label       types.String   `tfsdk:"label"`
+	Description types.String   `tfsdk:"description"`
+	Permissions []types.String `tfsdk:"permissions"`
+}
+
+func (r *IamRoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_role"
+}
+
+func (r *IamRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Computed: true,
+				Optional: false,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Computed: true,
+				Optional: true,
+				Default:  stringdefault.StaticString(""),
+			},
+			"permissions": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *IamRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *IamRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IamRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResult := client.CreateOrUpdateAuthorizationRole{}
+	variables := map[string]interface{}{
+		"name":        data.Name.ValueString(),
+		"label":       data.Name.ValueString(),
+		"description": data.Description.ValueString(),
+		"permissions": common.TfStringsTo[string](data.Permissions),
+	}
+
+	if err := r.client.Mutate(ctx, &createResult, variables); err == nil {
+		data.Label = types.StringValue(data.Name.ValueString())
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	} else {
+		to_print := fmt.Sprintf("MC client 'createOrUpdateAuthorizationRole' mutation result - %s", err.Error())
+		resp.Diagnostics.AddError(to_print, "")
+	}
+}
+
+func (r *IamRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IamRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, diags := getAuthorizationRoles(ctx, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if index := slices.IndexFunc(roles, func(role client.AuthorizationRole) bool {
+		return !role.IsManaged && role.Name == data.Name.ValueString()
+	}); index < 0 {
+		toPrint := fmt.Sprintf("MC client 'GetAuthorizationRoles' query failed to find role [name: %s]. "+
+			"This resource will be removed from the Terraform state without deletion.", data.Name.ValueString())
+		resp.Diagnostics.AddWarning(toPrint, "")
+		resp.State.RemoveResource(ctx)
+	} else {
+		data.Label = types.StringValue(roles[index].Label)
+		data.Description = types.StringValue(roles[index].Description)
+		data.Permissions = common.TfStringsFrom(roles[index].Permissions)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+func (r *IamRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data IamRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateResult := client.CreateOrUpdateAuthorizationRole{}
+	variables := map[string]interface{}{
+		"name":        data.Name.ValueString(),
+		"label":       data.Name.ValueString(),
+		"description": data.Description.ValueString(),
+		"permissions": common.TfStringsTo[string](data.Permissions),
+	}
+
+	if err := r.client.Mutate(ctx, &updateResult, variables); err == nil {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	} else {
+		to_print := fmt.Sprintf("MC client 'createOrUpdateAuthorizationRole' mutation result - %s", err.Error())
+		resp.Diagnostics.AddError(to_print, "")
+	}
+}
+
+func (r *IamRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IamRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteResult := client.DeleteAuthorizationRole{}
+	variables := map[string]interface{}{"name": data.Name.ValueString()}
+
+	if err := r.client.Mutate(ctx, &deleteResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'DeleteAuthorizationRole' mutation result - %s", err.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+		return
+	} else if deleteResult.DeleteAuthorizationRole.Deleted != 1 {
+		toPrint := fmt.Sprintf("MC client 'DeleteAuthorizationRole' mutation - deleted = %d, "+
+			"expected result is 1 - more roles might have been deleted. This resource "+
+			"will continue with its deletion", deleteResult.DeleteAuthorizationRole.Deleted)
+		resp.Diagnostics.AddWarning(toPrint, "")
+	}
+}
+
+func (r *IamRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+func getAuthorizationRoles(ctx context.Context, mcClient client.MonteCarloClient) ([]client.AuthorizationRole, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	getResult := client.GetAuthorizationRoles{}
+	if err := mcClient.Query(ctx, &getResult, map[string]interface{}{}); err != nil {
+		to_print := fmt.Sprintf("MC client 'GetAuthorizationRoles' query result - %s", err.Error())
+		diags.AddError(to_print, "")
+		return nil, diags
+	}
+	return getResult.GetAuthorizationRoles, diags
+}
+
+// validateRoles checks that all of the provided roles (builtin or custom) exist in the Monte Carlo account,
+// since the set of available roles differs per account and changes over time.
+func validateRoles(ctx context.Context, mcClient client.MonteCarloClient, attribute path.Path, roles []string) diag.Diagnostics {
+	available, diags := getAuthorizationRoles(ctx, mcClient)
+	if diags.HasError() {
+		return diags
+	}
+
+	names := make([]string, len(available))
+	for i, role := range available {
+		names[i] = role.Name
+	}
+
+	unknown := []string{}
+	for _, role := range roles {
+		if !slices.Contains(names, role) {
+			unknown = append(unknown, role)
+		}
+	}
+
+	if len(unknown) > 0 {
+		slices.Sort(names)
+		diags.AddAttributeError(attribute,
+			fmt.Sprintf("Roles [%d] not found", len(unknown)),
+			fmt.Sprintf("Roles %v do not exist in Monte Carlo, available roles:\n  - %s", unknown, strings.Join(names, "\n  - ")))
+	}
+	return diags
+}
@@ -0,0 +1,66 @@
+package authorization_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccIamRoleResource(t *testing.T) {
+	mc_api_key_id := os.Getenv("MC_API_KEY_ID")
+	mc_api_key_token := os.Getenv("MC_API_KEY_TOKEN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{ // Create and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("create.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_iam_role.test", "name", "TestAccIamRoleResource"),
+					resource.TestCheckResourceAttr("montecarlo_iam_role.test", "label", "TestAccIamRoleResource"),
+					resource.TestCheckResourceAttr("montecarlo_iam_role.test", "description", ""),
+					resource.TestCheckResourceAttr("montecarlo_iam_role.test", "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("montecarlo_iam_role.test", "permissions.*", "Monitors/Edit"),
+				),
+			},
+			{ // ImportState testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				ResourceName:      "montecarlo_iam_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["montecarlo_iam_role.test"].Primary.Attributes["name"], nil
+				},
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			{ // Update and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("update.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_iam_role.test", "description", "Role test description"),
+					resource.TestCheckResourceAttr("montecarlo_iam_role.test", "permissions.#", "1"),
+					resource.TestCheckTypeSetElemAttr("montecarlo_iam_role.test", "permissions.*", "Monitors/View"),
+					resource.TestCheckTypeSetElemAttr("montecarlo_iam_group.test", "roles.*", "TestAccIamRoleResource"),
+				),
+			},
+		},
+	})
+}
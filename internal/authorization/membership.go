@@ -0,0 +1,253 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Group memberships of the user are always updated as a whole (full list of user groups),
+// therefore concurrent updates of the same user within this provider process must be serialized,
+// otherwise the last writer wins and memberships written by others are lost.
+var userLocks sync.Map
+
+func lockUser(key string) func() {
+	mutex, _ := userLocks.LoadOrStore(strings.ToLower(key), &sync.Mutex{})
+	mutex.(*sync.Mutex).Lock()
+	return mutex.(*sync.Mutex).Unlock
+}
+
+// getUserByEmail returns the user with exactly matching email (case-insensitive), or nil if there is no such user.
+// The email filter of the API is not guaranteed to be exact (e.g. partial matches), therefore all of the matching
+// users are paged through until the exact match is found.
+func getUserByEmail(ctx context.Context, mcClient client.MonteCarloClient, email string) (*client.User, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	hasNextPage := true
+	variables := map[string]interface{}{
+		"email": email,
+		"first": 100,
+		"after": (*string)(nil),
+	}
+
+	for hasNextPage {
+		getUserResult := client.GetUsersInAccount{}
+		if err := mcClient.Query(ctx, &getUserResult, variables); err != nil {
+			to_print := fmt.Sprintf("MC client 'getUsersInAccount' query result - %s", err.Error())
+			diags.AddError(to_print, "")
+			return nil, diags
+		}
+
+		for _, edge := range getUserResult.GetUsersInAccount.Edges {
+			if strings.EqualFold(edge.Node.Email, email) {
+				return &edge.Node, diags
+			}
+		}
+
+		hasNextPage = getUserResult.GetUsersInAccount.PageInfo.HasNextPage
+		variables["after"] = getUserResult.GetUsersInAccount.PageInfo.EndCursor
+	}
+	return nil, diags
+}
+
+func getAuthorizationGroup(ctx context.Context, mcClient client.MonteCarloClient, groupName string) (*client.AuthorizationGroup, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	getGroupResult := client.GetAuthorizationGroups{}
+	if err := mcClient.Query(ctx, &getGroupResult, map[string]interface{}{}); err != nil {
+		to_print := fmt.Sprintf("MC client 'GetAuthorizationGroups' query result - %s", err.Error())
+		diags.AddError(to_print, "")
+		return nil, diags
+	}
+
+	if index := slices.IndexFunc(getGroupResult.GetAuthorizationGroups, func(group client.AuthorizationGroup) bool {
+		return group.Name == groupName
+	}); index >= 0 {
+		return &getGroupResult.GetAuthorizationGroups[index], diags
+	}
+	return nil, diags
+}
+
+func isSsoManaged(group *client.AuthorizationGroup) bool {
+	return group.SsoGroup != nil && *group.SsoGroup != ""
+}
+
+// Maximum number of attempts to modify groups of the user when concurrent changes are detected.
+const maxMembershipAttempts = 5
+
+// modifyUserGroups reads current groups of the user, modifies them and writes them back while
+// holding the per-user lock, so that read-modify-write cycles of the same user are never interleaved
+// within this provider process. Since the user can still be modified concurrently from the outside
+// (e.g. other Terraform runs or Monte Carlo UI), the groups actually added and removed by the mutation
+// are verified against the expected ones. Unexpected changes mean that some concurrent change was
+// overwritten - such changes are restored and the modification is retried with freshly read groups.
+func modifyUserGroups(ctx context.Context, mcClient client.MonteCarloClient, email string, modify func([]string) []string) (*client.User, diag.Diagnostics) {
+	unlock := lockUser(email)
+	defer unlock()
+
+	var diags diag.Diagnostics
+	restore, revert := map[string]struct{}{}, map[string]struct{}{}
+	for attempt := 1; ; attempt++ {
+		user, userDiags := getUserByEmail(ctx, mcClient, email)
+		diags.Append(userDiags...)
+		if diags.HasError() || user == nil {
+			return user, diags
+		}
+
+		current := user.Auth.Groups
+		desired := modify(slices.Clone(current))
+		for group := range restore {
+			desired = addGroup(group)(desired)
+		}
+		for group := range revert {
+			desired = removeGroup(group)(desired)
+		}
+
+		updateResult := client.UpdateUserAuthorizationGroupMembership{}
+		variables := map[string]interface{}{
+			"memberUserId": user.CognitoUserId,
+			"groupNames":   desired,
+		}
+
+		if err := mcClient.Mutate(ctx, &updateResult, variables); err != nil {
+			to_print := fmt.Sprintf("MC client 'updateUserAuthorizationGroupMembership' mutation result - %s", err.Error())
+			diags.AddError(to_print, "")
+			return user, diags
+		}
+
+		unexpectedAdded := unexpectedGroups(updateResult.UpdateUserAuthorizationGroupMembership.AddedToGroups, desired, current)
+		unexpectedRemoved := unexpectedGroups(updateResult.UpdateUserAuthorizationGroupMembership.RemovedFromGroups, current, desired)
+		if len(unexpectedAdded) == 0 && len(unexpectedRemoved) == 0 {
+			user.Auth.Groups = desired
+			return user, diags
+		} else if attempt >= maxMembershipAttempts {
+			diags.AddError(
+				fmt.Sprintf("Group memberships of user %s were concurrently modified", email),
+				fmt.Sprintf("Group memberships could not be updated without overwriting concurrent changes after %d attempts "+
+					"[unexpectedly added: %v, unexpectedly removed: %v]. Rerunning terraform operation usually helps.",
+					attempt, unexpectedAdded, unexpectedRemoved))
+			return user, diags
+		}
+
+		tflog.Warn(ctx, fmt.Sprintf("Concurrent modification of user %s group memberships detected, retrying "+
+			"[attempt: %d, unexpectedly added: %v, unexpectedly removed: %v]", email, attempt, unexpectedAdded, unexpectedRemoved))
+		for _, group := range unexpectedRemoved {
+			restore[group] = struct{}{}
+			delete(revert, group)
+		}
+		for _, group := range unexpectedAdded {
+			revert[group] = struct{}{}
+			delete(restore, group)
+		}
+	}
+}
+
+// unexpectedGroups returns groups reported as changed by the API, which were not expected to be changed,
+// i.e. groups that are not present in 'in' while being absent from 'notIn'.
+func unexpectedGroups(changed []struct {
+	Name        string
+	Label       string
+	Description string
+}, in []string, notIn []string) []string {
+	result := []string{}
+	for _, group := range changed {
+		if !slices.Contains(in, group.Name) || slices.Contains(notIn, group.Name) {
+			result = append(result, group.Name)
+		}
+	}
+	return result
+}
+
+func addGroup(groupName string) func([]string) []string {
+	return func(groups []string) []string {
+		if slices.Contains(groups, groupName) {
+			return groups
+		}
+		return append(groups, groupName)
+	}
+}
+
+func removeGroup(groupName string) func([]string) []string {
+	return func(groups []string) []string {
+		return slices.DeleteFunc(groups, func(name string) bool { return name == groupName })
+	}
+}
+
+func getServiceAccount(ctx context.Context, mcClient client.MonteCarloClient, tokenId string) (*client.TokenMetadata, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	type AccessKeyIndexEnum string
+	readResult := client.GetTokenMetadata{}
+	variables := map[string]interface{}{
+		"index":             (AccessKeyIndexEnum)("account"),
+		"isServiceApiToken": true,
+	}
+
+	if err := mcClient.Query(ctx, &readResult, variables); err != nil {
+		to_print := fmt.Sprintf("MC client 'GetTokenMetadata' query result - %s", err.Error())
+		diags.AddError(to_print, "")
+		return nil, diags
+	}
+
+	if index := slices.IndexFunc(readResult.GetTokenMetadata, func(token client.TokenMetadata) bool {
+		return token.Id == tokenId
+	}); index >= 0 {
+		return &readResult.GetTokenMetadata[index], diags
+	}
+	return nil, diags
+}
+
+// modifyServiceAccountGroups is the service account (token) counterpart of modifyUserGroups. Token groups
+// are also updated as a whole, however the token mutation does not report changed groups, therefore
+// the groups are read again after the mutation and retried when they do not match the written ones.
+// Other token attributes (comment, display name) are written back as read, expiration cannot be changed.
+func modifyServiceAccountGroups(ctx context.Context, mcClient client.MonteCarloClient, tokenId string, modify func([]string) []string) (*client.TokenMetadata, diag.Diagnostics) {
+	unlock := lockUser("serviceAccount:" + tokenId)
+	defer unlock()
+
+	var diags diag.Diagnostics
+	for attempt := 1; ; attempt++ {
+		token, tokenDiags := getServiceAccount(ctx, mcClient, tokenId)
+		diags.Append(tokenDiags...)
+		if diags.HasError() || token == nil {
+			return token, diags
+		}
+
+		desired := modify(slices.Clone(token.Groups))
+		updateResult := client.CreateOrUpdateServiceApiToken{}
+		variables := map[string]interface{}{
+			"tokenId":          tokenId,
+			"comment":          token.Comment,
+			"displayName":      &token.DisplayName, // preserves display name, since null would clear it
+			"expirationInDays": (*int)(nil),
+			"groups":           desired,
+		}
+
+		if err := mcClient.Mutate(ctx, &updateResult, variables); err != nil {
+			to_print := fmt.Sprintf("MC client 'CreateOrUpdateServiceApiToken' mutation result - %s", err.Error())
+			diags.AddError(to_print, "")
+			return token, diags
+		}
+
+		written, tokenDiags := getServiceAccount(ctx, mcClient, tokenId)
+		diags.Append(tokenDiags...)
+		if diags.HasError() || written == nil {
+			return written, diags
+		} else if slices.Equal(slices.Sorted(slices.Values(written.Groups)), slices.Sorted(slices.Values(desired))) {
+			return written, diags
+		} else if attempt >= maxMembershipAttempts {
+			diags.AddError(
+				fmt.Sprintf("Group memberships of service account %s were concurrently modified", tokenId),
+				fmt.Sprintf("Group memberships could not be verified after %d attempts [expected: %v, actual: %v]. "+
+					"Rerunning terraform operation usually helps.", attempt, desired, written.Groups))
+			return written, diags
+		}
+
+		tflog.Warn(ctx, fmt.Sprintf("Concurrent modification of service account %s group memberships detected, retrying "+
+			"[attempt: %d, expected: %v, actual: %v]", tokenId, attempt, desired, written.Groups))
+	}
+}
@@ -3,24 +3,33 @@ package authorization
 import (
 	"context"
 	"fmt"
-	"slices"
+	"strings"
+	"time"
 
 	"github.com/kiwicom/terraform-provider-montecarlo/client"
 	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ServiceAccountResource{}
-
-// This resource cannot be imported, since Token cannot be retrieved from Monte Carlo API.
-// var _ resource.ResourceWithImportState = &ServiceAccountResource{}
+var _ resource.ResourceWithModifyPlan = &ServiceAccountResource{}
+var _ resource.ResourceWithImportState = &ServiceAccountResource{}
 
 // To simplify provider implementations, a named function can be created with the resource implementation.
 func NewServiceAccountResource() resource.Resource {
@@ -34,9 +43,18 @@ type ServiceAccountResource struct {
 
 // ServiceAccountResourceModel describes the resource data model according to its Schema.
 type ServiceAccountResourceModel struct {
-	Id          types.String `tfsdk:"id"`
-	Token       types.String `tfsdk:"token"`
-	Description types.String `tfsdk:"description"`
+	Id                 types.String `tfsdk:"id"`
+	Token              types.String `tfsdk:"token"`
+	Description        types.String `tfsdk:"description"`
+	DisplayName        types.String `tfsdk:"display_name"`
+	ExpirationInDays   types.Int64  `tfsdk:"expiration_in_days"`
+	Groups             types.Set    `tfsdk:"groups"`
+	Keepers            types.Map    `tfsdk:"keepers"`
+	RotateAfterDays    types.Int64  `tfsdk:"rotate_after_days"`
+	RegenerateOnImport types.Bool   `tfsdk:"regenerate_on_import"`
+	CreationTime       types.String `tfsdk:"creation_time"`
+	ExpirationTime     types.String `tfsdk:"expiration_time"`
+	CreatedBy          types.String `tfsdk:"created_by"`
 }
 
 func (r *ServiceAccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -66,6 +84,67 @@ func (r *ServiceAccountResource) Schema(ctx context.Context, req resource.Schema
 				Optional: true,
 				Default:  stringdefault.StaticString(""),
 			},
+			"display_name": schema.StringAttribute{
+				Computed: true,
+				Optional: true,
+				Default:  stringdefault.StaticString(""),
+			},
+			"expiration_in_days": schema.Int64Attribute{
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"groups": schema.SetAttribute{
+				Computed:    true,
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(groupsRegex, "Expected format - groups/{group_name}"),
+					),
+				},
+			},
+			"keepers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"rotate_after_days": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"regenerate_on_import": schema.BoolAttribute{
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"creation_time": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expiration_time": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -83,24 +162,10 @@ func (r *ServiceAccountResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	createResult := client.CreateOrUpdateServiceApiToken{}
-	variables := map[string]interface{}{
-		"tokenId":          (*string)(nil),
-		"comment":          data.Description.ValueString(),
-		"displayName":      (*string)(nil),
-		"expirationInDays": (*int)(nil),
-		"groups":           (*[]string)(nil),
-	}
-
-	if err := r.client.Mutate(ctx, &createResult, variables); err != nil {
-		to_print := fmt.Sprintf("MC client 'CreateOrUpdateServiceApiToken' mutation result - %s", err.Error())
-		resp.Diagnostics.AddError(to_print, "")
-		return
+	resp.Diagnostics.Append(r.createToken(ctx, &data)...)
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	}
-
-	data.Id = types.StringValue(createResult.CreateOrUpdateServiceApiToken.AccessToken.Id)
-	data.Token = types.StringValue(createResult.CreateOrUpdateServiceApiToken.AccessToken.Token)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ServiceAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -110,55 +175,77 @@ func (r *ServiceAccountResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	var index int
-	type AccessKeyIndexEnum string
-	readResult := client.GetTokenMetadata{}
-	variables := map[string]interface{}{
-		"index":             (AccessKeyIndexEnum)("account"),
-		"isServiceApiToken": true,
-	}
-
-	if err := r.client.Query(ctx, &readResult, variables); err != nil {
-		to_print := fmt.Sprintf("MC client 'GetTokenMetadata' query result - %s", err.Error())
-		resp.Diagnostics.AddError(to_print, "")
+	token, diags := getServiceAccount(ctx, r.client, data.Id.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
-	}
-
-	if index = slices.IndexFunc(readResult.GetTokenMetadata, func(token client.TokenMetadata) bool {
-		return token.Id == data.Id.ValueString()
-	}); index < 0 {
+	} else if token == nil {
 		to_print := fmt.Sprintf("Token [ID: %s] not found", data.Id.ValueString())
 		resp.Diagnostics.AddWarning(to_print, "")
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	data.Description = types.StringValue(readResult.GetTokenMetadata[index].Comment)
+	resp.Diagnostics.Append(data.fromMetadata(ctx, token)...)
+	if data.ExpirationInDays.IsNull() {
+		data.ExpirationInDays = expirationInDays(token) // imported tokens
+	}
+	if data.RegenerateOnImport.IsNull() {
+		data.RegenerateOnImport = types.BoolValue(false)
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ServiceAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data ServiceAccountResourceModel
+	var state ServiceAccountResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// rotation - new token is created before the old one is deleted
+	if data.Id.IsUnknown() {
+		resp.Diagnostics.Append(r.createToken(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(deleteToken(ctx, r.client, state.Id.ValueString())...)
+		return
+	}
+
+	var configGroups types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("groups"), &configGroups)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	updateResult := client.CreateOrUpdateServiceApiToken{}
-	variables := map[string]interface{}{
-		"tokenId":          data.Id.ValueString(),
-		"comment":          data.Description.ValueString(),
-		"displayName":      (*string)(nil),
-		"expirationInDays": (*int)(nil),
-		"groups":           (*[]string)(nil),
+	variables := data.tokenVariables(data.Id.ValueString())
+	variables["expirationInDays"] = (*int)(nil) // expiration can be changed only by rotation
+	if configGroups.IsNull() {
+		variables["groups"] = (*[]string)(nil) // groups not managed by this resource (e.g. by iam_member)
 	}
 
-	if err := r.client.Mutate(ctx, &updateResult, variables); err == nil {
-		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-	} else {
+	// token groups are written as a whole, same as by iam_member service account memberships
+	unlock := lockUser("serviceAccount:" + data.Id.ValueString())
+	defer unlock()
+
+	if err := r.client.Mutate(ctx, &updateResult, variables); err != nil {
 		to_print := fmt.Sprintf("MC client 'CreateOrUpdateServiceApiToken' mutation result - %s", err.Error())
 		resp.Diagnostics.AddError(to_print, "")
+		return
 	}
+
+	token, diags := getServiceAccount(ctx, r.client, data.Id.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if token != nil {
+		resp.Diagnostics.Append(data.fromMetadata(ctx, token)...)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ServiceAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -167,16 +254,149 @@ func (r *ServiceAccountResource) Delete(ctx context.Context, req resource.Delete
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(deleteToken(ctx, r.client, data.Id.ValueString())...)
+}
+
+func (r *ServiceAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("token"), types.StringNull())...)
+	resp.Diagnostics.AddWarning(
+		fmt.Sprintf("Token of the imported service account [ID: %s] cannot be retrieved", req.ID),
+		"Monte Carlo API returns the token only when it is created, therefore 'token' attribute of the imported "+
+			"service account is null. Set 'regenerate_on_import = true' to rotate the token by the next apply.",
+	)
+}
+
+// ModifyPlan plans rotation of the token (all of the token dependent attributes become unknown)
+// when keepers or expiration change, or when the token is older than configured rotate_after_days.
+// Changes of keepers and expiration do not rotate imported tokens (unknown secret), regenerate_on_import does.
+func (r *ServiceAccountResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return // nothing to rotate during creation or deletion
+	}
 
+	var plan ServiceAccountResourceModel
+	var state ServiceAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rotate := !plan.Keepers.Equal(state.Keepers) || !plan.ExpirationInDays.Equal(state.ExpirationInDays)
+	if state.Token.IsNull() {
+		rotate = plan.RegenerateOnImport.ValueBool()
+	}
+	if !plan.RotateAfterDays.IsNull() && !plan.RotateAfterDays.IsUnknown() {
+		if created, err := time.Parse(time.RFC3339, state.CreationTime.ValueString()); err != nil {
+			to_print := fmt.Sprintf("Creation time of token [ID: %s] could not be parsed, rotation is skipped", state.Id.ValueString())
+			resp.Diagnostics.AddWarning(to_print, err.Error())
+		} else if time.Since(created) >= time.Duration(plan.RotateAfterDays.ValueInt64())*24*time.Hour {
+			rotate = true
+		}
+	}
+
+	if rotate {
+		for _, attribute := range []string{"id", "token", "creation_time", "expiration_time", "created_by"} {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attribute), types.StringUnknown())...)
+		}
+	}
+}
+
+func (r *ServiceAccountResource) createToken(ctx context.Context, data *ServiceAccountResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	createResult := client.CreateOrUpdateServiceApiToken{}
+	if err := r.client.Mutate(ctx, &createResult, data.tokenVariables((*string)(nil))); err != nil {
+		to_print := fmt.Sprintf("MC client 'CreateOrUpdateServiceApiToken' mutation result - %s", err.Error())
+		diags.AddError(to_print, "")
+		return diags
+	}
+
+	data.Id = types.StringValue(createResult.CreateOrUpdateServiceApiToken.AccessToken.Id)
+	data.Token = types.StringValue(createResult.CreateOrUpdateServiceApiToken.AccessToken.Token)
+	token, tokenDiags := getServiceAccount(ctx, r.client, data.Id.ValueString())
+	diags.Append(tokenDiags...)
+	if token != nil {
+		diags.Append(data.fromMetadata(ctx, token)...)
+		if data.ExpirationInDays.IsUnknown() {
+			data.ExpirationInDays = expirationInDays(token)
+		}
+	} else {
+		data.CreationTime = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+		data.ExpirationTime = types.StringValue("")
+		data.CreatedBy = types.StringValue("")
+		if data.Groups.IsUnknown() {
+			data.Groups = types.SetValueMust(types.StringType, nil)
+		}
+		if data.ExpirationInDays.IsUnknown() {
+			data.ExpirationInDays = types.Int64Null()
+		}
+	}
+	return diags
+}
+
+func (m ServiceAccountResourceModel) tokenVariables(tokenId any) map[string]interface{} {
+	variables := map[string]interface{}{
+		"tokenId":          tokenId,
+		"comment":          m.Description.ValueString(),
+		"displayName":      m.DisplayName.ValueStringPointer(),
+		"expirationInDays": (*int)(nil),
+		"groups":           (*[]string)(nil),
+	}
+
+	if !m.ExpirationInDays.IsNull() && !m.ExpirationInDays.IsUnknown() {
+		expirationInDays := int(m.ExpirationInDays.ValueInt64())
+		variables["expirationInDays"] = &expirationInDays
+	}
+
+	if !m.Groups.IsNull() && !m.Groups.IsUnknown() {
+		groups := make([]string, 0, len(m.Groups.Elements()))
+		for _, group := range m.Groups.Elements() {
+			groups = append(groups, strings.Split(group.(types.String).ValueString(), "groups/")[1])
+		}
+		variables["groups"] = &groups
+	}
+	return variables
+}
+
+func (m *ServiceAccountResourceModel) fromMetadata(ctx context.Context, token *client.TokenMetadata) diag.Diagnostics {
+	groups := make([]string, len(token.Groups))
+	for i, group := range token.Groups {
+		groups[i] = "groups/" + group
+	}
+
+	var diags diag.Diagnostics
+	m.Description = types.StringValue(token.Comment)
+	m.DisplayName = types.StringValue(token.DisplayName)
+	m.CreationTime = types.StringValue(token.CreationTime)
+	m.ExpirationTime = types.StringValue(token.ExpirationTime)
+	m.CreatedBy = types.StringValue(token.CreatedBy)
+	m.Groups, diags = types.SetValueFrom(ctx, types.StringType, groups)
+	return diags
+}
+
+// expirationInDays derives expiration of the token in days from its creation and expiration times.
+func expirationInDays(token *client.TokenMetadata) types.Int64 {
+	created, createdErr := time.Parse(time.RFC3339, token.CreationTime)
+	expires, expiresErr := time.Parse(time.RFC3339, token.ExpirationTime)
+	if createdErr != nil || expiresErr != nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(expires.Sub(created).Round(24*time.Hour) / (24 * time.Hour)))
+}
+
+func deleteToken(ctx context.Context, mcClient client.MonteCarloClient, tokenId string) diag.Diagnostics {
+	var diags diag.Diagnostics
 	deleteResult := client.DeleteAccessToken{}
-	variables := map[string]interface{}{"tokenId": data.Id.ValueString()}
+	variables := map[string]interface{}{"tokenId": tokenId}
 
-	if err := r.client.Mutate(ctx, &deleteResult, variables); err != nil {
+	if err := mcClient.Mutate(ctx, &deleteResult, variables); err != nil {
 		to_print := fmt.Sprintf("MC client 'DeleteAccessToken' mutation result - %s", err.Error())
-		resp.Diagnostics.AddError(to_print, "")
+		diags.AddError(to_print, "")
 	} else if !deleteResult.DeleteAccessToken.Success {
 		toPrint := "MC client 'DeleteAccessToken' mutation - success = false, " +
 			"service account probably already doesn't exists. This resource will continue with its deletion"
-		resp.Diagnostics.AddWarning(toPrint, "")
+		diags.AddWarning(toPrint, "")
 	}
+	return diags
 }
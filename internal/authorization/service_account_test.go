@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccServiceAccountResource(t *testing.T) {
@@ -26,8 +27,26 @@ func TestAccServiceAccountResource(t *testing.T) {
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("montecarlo_service_account.test", "description", ""),
+					resource.TestCheckResourceAttr("montecarlo_service_account.test", "display_name", ""),
+					resource.TestCheckResourceAttr("montecarlo_service_account.test", "groups.#", "0"),
+					resource.TestCheckResourceAttrSet("montecarlo_service_account.test", "creation_time"),
+					resource.TestCheckResourceAttrSet("montecarlo_service_account.test", "created_by"),
 				),
 			},
+			{ // ImportState testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				ResourceName:      "montecarlo_service_account.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["montecarlo_service_account.test"].Primary.Attributes["id"], nil
+				},
+				ImportStateVerifyIgnore: []string{"token"}, // token cannot be retrieved from Monte Carlo API
+			},
 			{ // Update and Read testing
 				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
 				ConfigFile:               config.TestNameFile("update.tf"),
@@ -37,6 +56,22 @@ func TestAccServiceAccountResource(t *testing.T) {
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("montecarlo_service_account.test", "description", "sa-test"),
+					resource.TestCheckResourceAttr("montecarlo_service_account.test", "display_name", "sa-test-name"),
+					resource.TestCheckTypeSetElemAttr("montecarlo_service_account.test", "groups.*", "groups/TestAccIamMemberResource"),
+				),
+			},
+			{ // Rotation testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("rotate.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_service_account.test", "keepers.version", "2"),
+					resource.TestCheckResourceAttr("montecarlo_service_account.test", "expiration_in_days", "30"),
+					resource.TestCheckResourceAttrSet("montecarlo_service_account.test", "expiration_time"),
+					resource.TestCheckTypeSetElemAttr("montecarlo_service_account.test", "groups.*", "groups/TestAccIamMemberResource"),
 				),
 			},
 		},
@@ -0,0 +1,156 @@
+package authorization
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Expiration of the ephemeral tokens if not configured - token is deleted on close anyway,
+// expiration only protects from leaking tokens when close is never called (e.g. killed Terraform run).
+const defaultEphemeralTokenExpirationInDays = 1
+
+// Key of the private data holding ID of the opened token.
+const privateTokenIdKey = "token_id"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &ServiceAccountTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ServiceAccountTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &ServiceAccountTokenEphemeralResource{}
+
+// To simplify provider implementations, a named function can be created with the resource implementation.
+func NewServiceAccountTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &ServiceAccountTokenEphemeralResource{}
+}
+
+// ServiceAccountTokenEphemeralResource defines the ephemeral resource implementation. Unlike
+// ServiceAccountResource, created token lives only during the Terraform run and is never stored in the state.
+type ServiceAccountTokenEphemeralResource struct {
+	client client.MonteCarloClient
+}
+
+// ServiceAccountTokenEphemeralResourceModel describes the ephemeral resource data model according to its Schema.
+type ServiceAccountTokenEphemeralResourceModel struct {
+	Id               types.String   `tfsdk:"id"`
+	Token            types.String   `tfsdk:"token"`
+	Description      types.String   `tfsdk:"description"`
+	DisplayName      types.String   `tfsdk:"display_name"`
+	ExpirationInDays types.Int64    `tfsdk:"expiration_in_days"`
+	Groups           []types.String `tfsdk:"groups"`
+}
+
+func (r *ServiceAccountTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_account_token"
+}
+
+func (r *ServiceAccountTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			"display_name": schema.StringAttribute{
+				Optional: true,
+			},
+			"expiration_in_days": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"groups": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(groupsRegex, "Expected format - groups/{group_name}"),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *ServiceAccountTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *ServiceAccountTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ServiceAccountTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expirationInDays := defaultEphemeralTokenExpirationInDays
+	if !data.ExpirationInDays.IsNull() {
+		expirationInDays = int(data.ExpirationInDays.ValueInt64())
+	}
+
+	groups := (*[]string)(nil)
+	if data.Groups != nil {
+		names := make([]string, 0, len(data.Groups))
+		for _, group := range data.Groups {
+			names = append(names, strings.Split(group.ValueString(), "groups/")[1])
+		}
+		groups = &names
+	}
+
+	createResult := client.CreateOrUpdateServiceApiToken{}
+	variables := map[string]interface{}{
+		"tokenId":          (*string)(nil),
+		"comment":          data.Description.ValueString(),
+		"displayName":      data.DisplayName.ValueStringPointer(),
+		"expirationInDays": &expirationInDays,
+		"groups":           groups,
+	}
+
+	if err := r.client.Mutate(ctx, &createResult, variables); err != nil {
+		to_print := fmt.Sprintf("MC client 'CreateOrUpdateServiceApiToken' mutation result - %s", err.Error())
+		resp.Diagnostics.AddError(to_print, "")
+		return
+	}
+
+	data.Id = types.StringValue(createResult.CreateOrUpdateServiceApiToken.AccessToken.Id)
+	data.Token = types.StringValue(createResult.CreateOrUpdateServiceApiToken.AccessToken.Token)
+	data.ExpirationInDays = types.Int64Value(int64(expirationInDays))
+	tokenId, _ := json.Marshal(data.Id.ValueString()) // private data must be valid JSON
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateTokenIdKey, tokenId)...)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *ServiceAccountTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateTokenId, diags := req.Private.GetKey(ctx, privateTokenIdKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateTokenId == nil {
+		return
+	}
+
+	var tokenId string
+	if err := json.Unmarshal(privateTokenId, &tokenId); err != nil {
+		resp.Diagnostics.AddError("Failed to read ID of the opened token from private data", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(deleteToken(ctx, r.client, tokenId)...)
+}
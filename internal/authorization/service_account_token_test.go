@@ -0,0 +1,39 @@
+package authorization_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccServiceAccountTokenEphemeralResource(t *testing.T) {
+	mc_api_key_id := os.Getenv("MC_API_KEY_ID")
+	mc_api_key_token := os.Getenv("MC_API_KEY_TOKEN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0), // ephemeral resources
+		},
+		Steps: []resource.TestStep{
+			{ // Open testing
+				ProtoV6ProviderFactories: acctest.TestAccEphemeralProviderFactories,
+				ConfigFile:               config.TestNameFile("open.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("echo.test", "data.id"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.token"),
+					resource.TestCheckResourceAttr("echo.test", "data.expiration_in_days", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,28 @@
+variable "montecarlo_api_key_id" {
+  type = string
+}
+
+variable "montecarlo_api_key_token" {
+  type = string
+}
+
+provider "montecarlo" {
+  account_service_key = {
+    id    = var.montecarlo_api_key_id     # (secret)
+    token = var.montecarlo_api_key_token  # (secret)
+  }
+}
+
+resource "montecarlo_iam_group" "test" {
+  name = "TestAccIamGroupMembersResource"
+  role = "mcd/viewer"
+}
+
+resource "montecarlo_iam_group_members" "test" {
+  group = "groups/${montecarlo_iam_group.test.name}"
+  members = [
+    "user:ndopjera@gmail.com",
+    "user:unknown-1@terraform-provider-montecarlo.test",
+    "user:unknown-2@terraform-provider-montecarlo.test",
+  ]
+}
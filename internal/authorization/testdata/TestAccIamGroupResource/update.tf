@@ -15,7 +15,7 @@ provider "montecarlo" {
 
 resource "montecarlo_iam_group" "test" {
 	name = "group-1"
-	role = "mcd/viewer"
+	roles = ["mcd/viewer", "mcd/responder"]
     sso_group = "ssoGroup1"
     domains = [
         "ba0c4080-089d-4377-8878-466c31d19807",
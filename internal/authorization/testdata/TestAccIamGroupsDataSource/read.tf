@@ -0,0 +1,24 @@
+variable "montecarlo_api_key_id" {
+  type = string
+}
+
+variable "montecarlo_api_key_token" {
+  type = string
+}
+
+provider "montecarlo" {
+  account_service_key = {
+    id    = var.montecarlo_api_key_id     # (secret)
+    token = var.montecarlo_api_key_token  # (secret)
+  }
+}
+
+resource "montecarlo_iam_group" "test" {
+  name        = "TestAccIamGroupsDataSource"
+  description = "Groups data source test description"
+  role        = "mcd/viewer"
+}
+
+data "montecarlo_iam_groups" "test" {
+  depends_on = [montecarlo_iam_group.test]
+}
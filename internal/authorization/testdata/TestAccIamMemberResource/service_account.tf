@@ -0,0 +1,32 @@
+variable "montecarlo_api_key_id" {
+  type = string
+}
+
+variable "montecarlo_api_key_token" {
+  type = string
+}
+
+provider "montecarlo" {
+  account_service_key = {
+    id    = var.montecarlo_api_key_id     # (secret)
+    token = var.montecarlo_api_key_token  # (secret)
+  }
+}
+
+resource "montecarlo_iam_member" "test" {
+	group = "groups/TestAccIamMemberResource2"
+	member = "user:ndopjera@gmail.com"
+}
+
+resource "montecarlo_service_account" "test" {}
+
+resource "montecarlo_iam_member" "service_account" {
+	group = "groups/TestAccIamMemberResource2"
+	member = "serviceAccount:${montecarlo_service_account.test.id}"
+}
+
+resource "montecarlo_iam_member" "read_only" {
+	group = montecarlo_iam_member.test.group
+	member = montecarlo_iam_member.test.member
+	read_only = true
+}
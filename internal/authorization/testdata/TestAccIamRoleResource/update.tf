@@ -0,0 +1,25 @@
+variable "montecarlo_api_key_id" {
+  type = string
+}
+
+variable "montecarlo_api_key_token" {
+  type = string
+}
+
+provider "montecarlo" {
+  account_service_key = {
+    id    = var.montecarlo_api_key_id     # (secret)
+    token = var.montecarlo_api_key_token  # (secret)
+  }
+}
+
+resource "montecarlo_iam_role" "test" {
+  name        = "TestAccIamRoleResource"
+  description = "Role test description"
+  permissions = ["Monitors/View"]
+}
+
+resource "montecarlo_iam_group" "test" {
+  name  = "TestAccIamRoleResource"
+  roles = ["mcd/viewer", montecarlo_iam_role.test.name]
+}
@@ -0,0 +1,25 @@
+variable "montecarlo_api_key_id" {
+  type = string
+}
+
+variable "montecarlo_api_key_token" {
+  type = string
+}
+
+provider "montecarlo" {
+  account_service_key = {
+    id    = var.montecarlo_api_key_id     # (secret)
+    token = var.montecarlo_api_key_token  # (secret)
+  }
+}
+
+resource "montecarlo_service_account" "test" {
+  description  = "sa-test"
+  display_name = "sa-test-name"
+  groups       = ["groups/TestAccIamMemberResource"]
+
+  expiration_in_days = 30
+  keepers = {
+    version = "2"
+  }
+}
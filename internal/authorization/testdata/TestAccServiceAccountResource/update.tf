@@ -14,5 +14,7 @@ provider "montecarlo" {
 }
 
 resource "montecarlo_service_account" "test" {
-  description = "sa-test"
+  description  = "sa-test"
+  display_name = "sa-test-name"
+  groups       = ["groups/TestAccIamMemberResource"]
 }
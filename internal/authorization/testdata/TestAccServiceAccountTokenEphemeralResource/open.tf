@@ -0,0 +1,25 @@
+variable "montecarlo_api_key_id" {
+  type = string
+}
+
+variable "montecarlo_api_key_token" {
+  type = string
+}
+
+provider "montecarlo" {
+  account_service_key = {
+    id    = var.montecarlo_api_key_id     # (secret)
+    token = var.montecarlo_api_key_token  # (secret)
+  }
+}
+
+ephemeral "montecarlo_service_account_token" "test" {
+  description = "TestAccServiceAccountTokenEphemeralResource"
+  groups      = ["groups/TestAccIamMemberResource"]
+}
+
+provider "echo" {
+  data = ephemeral.montecarlo_service_account_token.test
+}
+
+resource "echo" "test" {}
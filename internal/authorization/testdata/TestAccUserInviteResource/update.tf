@@ -0,0 +1,19 @@
+variable "montecarlo_api_key_id" {
+  type = string
+}
+
+variable "montecarlo_api_key_token" {
+  type = string
+}
+
+provider "montecarlo" {
+  account_service_key = {
+    id    = var.montecarlo_api_key_id     # (secret)
+    token = var.montecarlo_api_key_token  # (secret)
+  }
+}
+
+resource "montecarlo_user_invite" "test" {
+  email  = "terraform-provider-montecarlo+invite@gmail.com"
+  groups = ["groups/TestAccIamMemberResource", "groups/TestAccIamMemberResource2"]
+}
@@ -0,0 +1,111 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &UserDataSource{}
+
+func NewUserDatasource() datasource.DataSource {
+	return &UserDataSource{}
+}
+
+type UserDataSource struct {
+	client client.MonteCarloClient
+}
+
+type UserDataSourceModel struct {
+	Email         types.String   `tfsdk:"email"`
+	Member        types.String   `tfsdk:"member"`
+	CognitoUserId types.String   `tfsdk:"cognito_user_id"`
+	FirstName     types.String   `tfsdk:"first_name"`
+	LastName      types.String   `tfsdk:"last_name"`
+	IsSso         types.Bool     `tfsdk:"is_sso"`
+	Groups        []types.String `tfsdk:"groups"`
+}
+
+func NewUserDataSourceModel(in client.User) UserDataSourceModel {
+	result := UserDataSourceModel{
+		Email:         types.StringValue(in.Email),
+		Member:        types.StringValue("user:" + in.Email),
+		CognitoUserId: types.StringValue(in.CognitoUserId),
+		FirstName:     types.StringValue(in.FirstName),
+		LastName:      types.StringValue(in.LastName),
+		IsSso:         types.BoolValue(in.IsSso),
+		Groups:        make([]types.String, 0, len(in.Auth.Groups)),
+	}
+	for _, group := range in.Auth.Groups {
+		result.Groups = append(result.Groups, types.StringValue("groups/"+group))
+	}
+	return result
+}
+
+func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				Required: true,
+			},
+			"member": schema.StringAttribute{
+				Computed: true,
+			},
+			"cognito_user_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"first_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"last_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"is_sso": schema.BoolAttribute{
+				Computed: true,
+			},
+			"groups": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *UserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	d.client = client
+}
+
+func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, diags := getUserByEmail(ctx, d.client, data.Email.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	} else if user == nil {
+		to_print := fmt.Sprintf("User %s not found", data.Email.ValueString())
+		resp.Diagnostics.AddError(to_print, "")
+		return
+	}
+
+	email := data.Email // keeping the configured letter case of email
+	data = NewUserDataSourceModel(*user)
+	data.Email = email
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,270 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// State of the invitation once the invited user signed up (invitation is no longer listed by the API).
+const inviteStateAccepted = "ACCEPTED"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserInviteResource{}
+var _ resource.ResourceWithImportState = &UserInviteResource{}
+
+// To simplify provider implementations, a named function can be created with the resource implementation.
+func NewUserInviteResource() resource.Resource {
+	return &UserInviteResource{}
+}
+
+// UserInviteResource defines the resource implementation.
+type UserInviteResource struct {
+	client client.MonteCarloClient
+}
+
+// UserInviteResourceModel describes the resource data model according to its Schema.
+type UserInviteResourceModel struct {
+	Email  types.String   `tfsdk:"email"`
+	Groups []types.String `tfsdk:"groups"`
+	State  types.String   `tfsdk:"state"`
+}
+
+func (r *UserInviteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_invite"
+}
+
+func (r *UserInviteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"groups": schema.SetAttribute{
+				Computed:    true,
+				Optional:    true,
+				ElementType: types.StringType,
+				Default: setdefault.StaticValue(
+					types.SetValueMust(
+						types.StringType,
+						[]attr.Value{},
+					),
+				),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(groupsRegex, "Expected format - groups/{group_name}"),
+					),
+				},
+			},
+			"state": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *UserInviteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *UserInviteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserInviteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	invite, diags := r.sendInvite(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if !resp.Diagnostics.HasError() {
+		data.State = types.StringValue(invite.State)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+func (r *UserInviteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserInviteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	invite, diags := findUserInvite(ctx, r.client, data.Email.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	} else if invite != nil {
+		data.State = types.StringValue(invite.State)
+		data.Groups = make([]types.String, 0, len(invite.Groups))
+		for _, group := range invite.Groups {
+			data.Groups = append(data.Groups, types.StringValue("groups/"+group))
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// accepted invitations are no longer listed, however the invited user exists
+	user, diags := getUserByEmail(ctx, r.client, data.Email.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	} else if user == nil {
+		to_print := fmt.Sprintf("Invitation of %s not found (revoked outside of Terraform)", data.Email.ValueString())
+		resp.Diagnostics.AddWarning(to_print, "")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.State = types.StringValue(inviteStateAccepted)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserInviteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UserInviteResourceModel
+	var state UserInviteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.State.ValueString() == inviteStateAccepted {
+		to_print := fmt.Sprintf("Invitation of %s was already accepted, change of its groups has no effect", data.Email.ValueString())
+		resp.Diagnostics.AddWarning(to_print, "Use 'montecarlo_iam_member' resources to manage groups of existing users.")
+		data.State = state.State
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// pending invitation is revoked and sent again with the new groups
+	resp.Diagnostics.Append(removeUserInvite(ctx, r.client, data.Email.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	invite, diags := r.sendInvite(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if !resp.Diagnostics.HasError() {
+		data.State = types.StringValue(invite.State)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+func (r *UserInviteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserInviteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.State.ValueString() == inviteStateAccepted {
+		return // accepted invitations are only removed from the Terraform state
+	}
+	resp.Diagnostics.Append(removeUserInvite(ctx, r.client, data.Email.ValueString())...)
+}
+
+func (r *UserInviteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("email"), req, resp)
+}
+
+func (r *UserInviteResource) sendInvite(ctx context.Context, data UserInviteResourceModel) (*client.UserInvite, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	groups := make([]string, 0, len(data.Groups))
+	for _, group := range data.Groups {
+		groups = append(groups, strings.Split(group.ValueString(), "groups/")[1])
+	}
+
+	sendResult := client.SendUserInvite{}
+	variables := map[string]interface{}{
+		"emails": []string{data.Email.ValueString()},
+		"groups": groups,
+	}
+
+	if err := r.client.Mutate(ctx, &sendResult, variables); err != nil {
+		to_print := fmt.Sprintf("MC client 'SendUserInvite' mutation result - %s", err.Error())
+		diags.AddError(to_print, "")
+		return nil, diags
+	} else if len(sendResult.SendUserInvite.ExistingUsers) > 0 {
+		to_print := fmt.Sprintf("User %s already exists", data.Email.ValueString())
+		diags.AddError(to_print, "Use 'montecarlo_iam_member' resources to manage groups of existing users.")
+		return nil, diags
+	} else if len(sendResult.SendUserInvite.AlreadyInvited) > 0 {
+		to_print := fmt.Sprintf("User %s is already invited", data.Email.ValueString())
+		diags.AddError(to_print, "Existing invitations can be imported using the email as the import ID.")
+		return nil, diags
+	}
+
+	if index := slices.IndexFunc(sendResult.SendUserInvite.Invites, func(invite client.UserInvite) bool {
+		return strings.EqualFold(invite.Email, data.Email.ValueString())
+	}); index >= 0 {
+		return &sendResult.SendUserInvite.Invites[index], diags
+	}
+
+	to_print := fmt.Sprintf("MC client 'SendUserInvite' mutation - invitation of %s not returned", data.Email.ValueString())
+	diags.AddError(to_print, "")
+	return nil, diags
+}
+
+func findUserInvite(ctx context.Context, mcClient client.MonteCarloClient, email string) (*client.UserInvite, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var after *string
+	for {
+		getInvitesResult := client.GetInvitesInAccount{}
+		variables := map[string]interface{}{
+			"first": usersPageSize,
+			"after": after,
+		}
+
+		if err := mcClient.Query(ctx, &getInvitesResult, variables); err != nil {
+			to_print := fmt.Sprintf("MC client 'getInvitesInAccount' query result - %s", err.Error())
+			diags.AddError(to_print, "")
+			return nil, diags
+		}
+
+		for _, edge := range getInvitesResult.GetInvitesInAccount.Edges {
+			if strings.EqualFold(edge.Node.Email, email) {
+				return &edge.Node, diags
+			}
+		}
+
+		pageInfo := getInvitesResult.GetInvitesInAccount.PageInfo
+		if !pageInfo.HasNextPage || pageInfo.EndCursor == "" {
+			return nil, diags
+		}
+		after = &pageInfo.EndCursor
+	}
+}
+
+func removeUserInvite(ctx context.Context, mcClient client.MonteCarloClient, email string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	removeResult := client.RemoveUserInvite{}
+	variables := map[string]interface{}{"email": email}
+
+	if err := mcClient.Mutate(ctx, &removeResult, variables); err != nil {
+		to_print := fmt.Sprintf("MC client 'RemoveUserInvite' mutation result - %s", err.Error())
+		diags.AddError(to_print, "")
+	} else if !removeResult.RemoveUserInvite.Success {
+		toPrint := "MC client 'RemoveUserInvite' mutation - success = false, " +
+			"invitation probably already doesn't exists. This resource will continue with its operation"
+		diags.AddWarning(toPrint, "")
+	}
+	return diags
+}
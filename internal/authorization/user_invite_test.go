@@ -0,0 +1,59 @@
+package authorization_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserInviteResource(t *testing.T) {
+	mc_api_key_id := os.Getenv("MC_API_KEY_ID")
+	mc_api_key_token := os.Getenv("MC_API_KEY_TOKEN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{ // Create and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("create.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_user_invite.test", "email", "terraform-provider-montecarlo+invite@gmail.com"),
+					resource.TestCheckResourceAttr("montecarlo_user_invite.test", "groups.#", "1"),
+					resource.TestCheckResourceAttrSet("montecarlo_user_invite.test", "state"),
+				),
+			},
+			{ // ImportState testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				ResourceName:                         "montecarlo_user_invite.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "terraform-provider-montecarlo+invite@gmail.com",
+				ImportStateVerifyIdentifierAttribute: "email",
+			},
+			{ // Update and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("update.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_user_invite.test", "groups.#", "2"),
+					resource.TestCheckTypeSetElemAttr("montecarlo_user_invite.test", "groups.*", "groups/TestAccIamMemberResource2"),
+				),
+			},
+		},
+	})
+}
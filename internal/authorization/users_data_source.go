@@ -0,0 +1,112 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &UsersDataSource{}
+
+// Page size used for reading users of the account.
+const usersPageSize = 100
+
+func NewUsersDatasource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+type UsersDataSource struct {
+	client client.MonteCarloClient
+}
+
+type UsersDataSourceModel struct {
+	Users []UserDataSourceModel `tfsdk:"users"`
+}
+
+func (d *UsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"users": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							Computed: true,
+						},
+						"member": schema.StringAttribute{
+							Computed: true,
+						},
+						"cognito_user_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"first_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"last_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"is_sso": schema.BoolAttribute{
+							Computed: true,
+						},
+						"groups": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	d.client = client
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var after *string
+	data.Users = []UserDataSourceModel{}
+	for {
+		getUsersResult := client.GetUsersInAccount{}
+		variables := map[string]interface{}{
+			"email": (*string)(nil),
+			"first": usersPageSize,
+			"after": after,
+		}
+
+		if err := d.client.Query(ctx, &getUsersResult, variables); err != nil {
+			to_print := fmt.Sprintf("MC client 'getUsersInAccount' query result - %s", err.Error())
+			resp.Diagnostics.AddError(to_print, "")
+			return
+		}
+
+		for _, edge := range getUsersResult.GetUsersInAccount.Edges {
+			data.Users = append(data.Users, NewUserDataSourceModel(edge.Node))
+		}
+
+		pageInfo := getUsersResult.GetUsersInAccount.PageInfo
+		if !pageInfo.HasNextPage || pageInfo.EndCursor == "" {
+			break
+		}
+		after = &pageInfo.EndCursor
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -2,11 +2,13 @@ package common
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/kiwicom/terraform-provider-montecarlo/client"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -56,13 +58,15 @@ func FromTagPairs(in []client.TagKeyValuePairOutput) []TagModel {
 	return tagModels
 }
 
-func Configure[Req resource.ConfigureRequest | datasource.ConfigureRequest](req Req) (client.MonteCarloClient, diag.Diagnostics) {
+func Configure[Req resource.ConfigureRequest | datasource.ConfigureRequest | ephemeral.ConfigureRequest](req Req) (client.MonteCarloClient, diag.Diagnostics) {
 	var providerData any
 	switch request := any(req).(type) {
 	case resource.ConfigureRequest:
 		providerData = request.ProviderData
 	case datasource.ConfigureRequest:
 		providerData = request.ProviderData
+	case ephemeral.ConfigureRequest:
+		providerData = request.ProviderData
 	}
 
 	var diags diag.Diagnostics
@@ -93,3 +97,18 @@ func TfStringsFrom(in []string) []types.String {
 	}
 	return res
 }
+
+// SameTimestamp reports whether the state value and the API value represent the same point in time,
+// since API might return the timestamp in a different (but equal) format than configured.
+func SameTimestamp(state types.String, remote *string) bool {
+	if state.IsNull() || remote == nil {
+		return state.IsNull() && remote == nil
+	}
+
+	stateTime, stateErr := time.Parse(time.RFC3339, state.ValueString())
+	remoteTime, remoteErr := time.Parse(time.RFC3339, *remote)
+	if stateErr != nil || remoteErr != nil {
+		return state.ValueString() == *remote
+	}
+	return stateTime.Equal(remoteTime)
+}
@@ -0,0 +1,69 @@
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CredentialsTest tests credentials of the connection, which are temporarily stored by Monte Carlo under
+// the key returned in the result. Nil result is returned if the credentials could not be verified.
+type CredentialsTest[K comparable] func(ctx context.Context) (K, diag.Diagnostics)
+
+// AddConnection adds connection with the tested credentials, shared by warehouse and integration resources.
+// Variables of the 'addConnection' mutation are completed by the key of the tested credentials.
+func AddConnection[K comparable](ctx context.Context, mcClient client.MonteCarloClient, test CredentialsTest[K],
+	keyExtractor func(K) string, variables map[string]interface{}) (*client.AddConnection, diag.Diagnostics) {
+	var diagsResult diag.Diagnostics
+	var untested K
+	testResult, credentialsDiags := test(ctx)
+	diagsResult.Append(credentialsDiags...)
+	if testResult == untested {
+		return nil, diagsResult
+	}
+
+	addResult := client.AddConnection{}
+	variables["key"] = keyExtractor(testResult)
+	if err := mcClient.Mutate(ctx, &addResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'AddConnection' mutation result - %s", err.Error())
+		diagsResult.AddError(toPrint, "")
+		return nil, diagsResult
+	} else {
+		return &addResult, diagsResult
+	}
+}
+
+// UpdateConnection replaces credentials of the existing connection with the tested credentials.
+func UpdateConnection[K comparable](ctx context.Context, mcClient client.MonteCarloClient, test CredentialsTest[K],
+	keyExtractor func(K) string, connectionUuid types.String) (*client.UpdateCredentialsV2, diag.Diagnostics) {
+	var diagsResult diag.Diagnostics
+	var untested K
+	testResult, credentialsDiags := test(ctx)
+	diagsResult.Append(credentialsDiags...)
+	if testResult == untested {
+		return nil, diagsResult
+	}
+
+	updateResult := client.UpdateCredentialsV2{}
+	variables := map[string]interface{}{
+		"connectionId":       client.UUID(connectionUuid.ValueString()),
+		"tempCredentialsKey": keyExtractor(testResult),
+	}
+
+	if err := mcClient.Mutate(ctx, &updateResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'UpdateCredentials' mutation result - %s", err.Error())
+		diagsResult.AddError(toPrint, "")
+		return nil, diagsResult
+	} else if !updateResult.UpdateCredentialsV2.Success {
+		toPrint := "MC client 'UpdateCredentials' mutation - success = false, " +
+			"connection probably doesnt exists. Rerunning terraform operation usually helps."
+		diagsResult.AddError(toPrint, "")
+		return nil, diagsResult
+	} else {
+		return &updateResult, diagsResult
+	}
+}
@@ -0,0 +1,43 @@
+package common
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ planmodifier.Bool = credentialsDriftModifier{}
+
+// credentialsDriftModifier plans 'credentials_drifted' attribute back to false once a drift was detected during
+// Read, which results in an update re-applying the configured credentials (rotation).
+type credentialsDriftModifier struct{}
+
+func RotateCredentialsIfDrifted() planmodifier.Bool {
+	return credentialsDriftModifier{}
+}
+
+func (m credentialsDriftModifier) Description(ctx context.Context) string {
+	return "Forces rotation of the configured credentials if they were changed externally."
+}
+
+func (m credentialsDriftModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m credentialsDriftModifier) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	if req.Plan.Raw.IsNull() {
+		return // resource is being destroyed
+	}
+	// credentials are always (re)applied during create and update, plan does not have to stay unknown
+	resp.PlanValue = types.BoolValue(false)
+}
+
+// CredentialsDrifted reports whether credentials were updated externally since the last apply.
+func CredentialsDrifted(drifted types.Bool, stateUpdatedAt, readUpdatedAt types.String) types.Bool {
+	if drifted.ValueBool() {
+		return drifted // drift persists until credentials are rotated
+	}
+	changed := !stateUpdatedAt.IsNull() && !readUpdatedAt.IsNull() && !stateUpdatedAt.Equal(readUpdatedAt)
+	return types.BoolValue(changed)
+}
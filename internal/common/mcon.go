@@ -0,0 +1,307 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// MCON is essentially Monte Carlo universal identifier of the data object (asset).
+// Its format is following `MCON++{account_uuid}++{resource_uuid}++{object_type}++{object_id}`.
+const mconPrefix = "MCON"
+const mconSeparator = "++"
+
+var uuidRegex = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+var mconKindRegex = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ basetypes.StringTypable = MconType{}
+var _ basetypes.StringValuableWithSemanticEquals = MconValue{}
+var _ validator.String = mconValidator{}
+
+type Mcon struct {
+	AccountUuid  string
+	ResourceUuid string
+	Kind         string
+	Path         string
+}
+
+func NewMcon(accountUuid, resourceUuid, kind, path string) Mcon {
+	return Mcon{AccountUuid: accountUuid, ResourceUuid: resourceUuid, Kind: kind, Path: path}
+}
+
+// ParseMcon checks the `MCON++account++warehouse++kind++path` structure of the input and returns
+// its parts in the normalized form - surrounding whitespaces are dropped, UUIDs and kind are lowercased.
+// Path of the data object is case sensitive and therefore left untouched.
+func ParseMcon(in string) (Mcon, error) {
+	parts := strings.SplitN(strings.TrimSpace(in), mconSeparator, 5)
+	if len(parts) != 5 {
+		return Mcon{}, fmt.Errorf("expected format MCON++{account_uuid}++{resource_uuid}++{object_type}++{object_id}, got: %q", in)
+	} else if !strings.EqualFold(parts[0], mconPrefix) {
+		return Mcon{}, fmt.Errorf("expected %q prefix, got: %q", mconPrefix, parts[0])
+	}
+
+	mcon := NewMcon(strings.ToLower(parts[1]), strings.ToLower(parts[2]), strings.ToLower(parts[3]), parts[4])
+	if !uuidRegex.MatchString(mcon.AccountUuid) {
+		return Mcon{}, fmt.Errorf("account UUID %q is not a valid UUID", parts[1])
+	} else if !uuidRegex.MatchString(mcon.ResourceUuid) {
+		return Mcon{}, fmt.Errorf("resource (warehouse) UUID %q is not a valid UUID", parts[2])
+	} else if !mconKindRegex.MatchString(mcon.Kind) {
+		return Mcon{}, fmt.Errorf("object type %q is not valid (e.g. project, dataset, table, view)", parts[3])
+	} else if strings.TrimSpace(mcon.Path) == "" {
+		return Mcon{}, fmt.Errorf("object ID must not be empty")
+	}
+	return mcon, nil
+}
+
+func (m Mcon) String() string {
+	return strings.Join([]string{mconPrefix, m.AccountUuid, m.ResourceUuid, m.Kind, m.Path}, mconSeparator)
+}
+
+// NormalizeMcon returns the normalized form of the MCON if it is valid, otherwise the input itself.
+func NormalizeMcon(in string) string {
+	if mcon, err := ParseMcon(in); err == nil {
+		return mcon.String()
+	}
+	return in
+}
+
+// MconType is a custom string type used for attributes holding MCONs.
+// Its values are compared by their normalized form, validation is done by MconValidator.
+type MconType struct {
+	basetypes.StringType
+}
+
+func (t MconType) String() string {
+	return "common.MconType"
+}
+
+func (t MconType) Equal(o attr.Type) bool {
+	other, ok := o.(MconType)
+	return ok && t.StringType.Equal(other.StringType)
+}
+
+func (t MconType) ValueType(ctx context.Context) attr.Value {
+	return MconValue{}
+}
+
+func (t MconType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return MconValue{StringValue: in}, nil
+}
+
+func (t MconType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return stringValuable, nil
+}
+
+type MconValue struct {
+	basetypes.StringValue
+}
+
+func NewMconValue(in string) MconValue {
+	return MconValue{StringValue: basetypes.NewStringValue(in)}
+}
+
+func (v MconValue) Type(ctx context.Context) attr.Type {
+	return MconType{}
+}
+
+func (v MconValue) Equal(o attr.Value) bool {
+	other, ok := o.(MconValue)
+	return ok && v.StringValue.Equal(other.StringValue)
+}
+
+// Normalized returns the normalized MCON string, as it is expected by the Monte Carlo API.
+func (v MconValue) Normalized() string {
+	return NormalizeMcon(v.ValueString())
+}
+
+func (v MconValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(MconValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+	return v.Normalized() == newValue.Normalized(), diags
+}
+
+func MconsTo(in []MconValue) []string {
+	res := make([]string, len(in))
+	for i, element := range in {
+		res[i] = element.Normalized()
+	}
+	return res
+}
+
+func MconsFrom(in []string) []MconValue {
+	res := make([]MconValue, len(in))
+	for i, element := range in {
+		res[i] = NewMconValue(element)
+	}
+	return res
+}
+
+// MconValidator validates that the configured string is a well formed MCON. It is expected on every
+// configurable attribute of MconType, directly or in collection validators (e.g. `setvalidator.ValueStringsAre`),
+// so that all invalid MCONs are reported during `terraform validate`.
+func MconValidator() validator.String {
+	return mconValidator{}
+}
+
+type mconValidator struct{}
+
+func (v mconValidator) Description(ctx context.Context) string {
+	return "value must be a valid MCON (MCON++{account_uuid}++{resource_uuid}++{object_type}++{object_id})"
+}
+
+func (v mconValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v mconValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	} else if _, err := ParseMcon(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid MCON", err.Error())
+	}
+}
+
+// UuidValidator validates that the configured string is a well formed UUID (e.g. warehouse UUID).
+func UuidValidator() validator.String {
+	return stringvalidator.RegexMatches(regexp.MustCompile("(?i)"+uuidRegex.String()), "must be a valid UUID")
+}
+
+// FindUnresolvedMcons returns those of the given MCONs which do not resolve to any existing
+// (active) data object in Monte Carlo. Tables (and other leaf objects) are looked up directly
+// by their MCONs, project and dataset MCONs are resolved against the datasets of their warehouse,
+// which are listed only if any such MCON references the warehouse.
+func FindUnresolvedMcons(ctx context.Context, mcClient client.MonteCarloClient, in []string) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	containers := map[string][]Mcon{}
+	leaves := []Mcon{}
+	for _, element := range in {
+		if mcon, err := ParseMcon(element); err != nil {
+			diags.AddError(fmt.Sprintf("Invalid MCON %q", element), err.Error())
+		} else if mcon.Kind == "project" || mcon.Kind == "dataset" {
+			containers[mcon.ResourceUuid] = append(containers[mcon.ResourceUuid], mcon)
+		} else {
+			leaves = append(leaves, mcon)
+		}
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	unresolved := []string{}
+	for _, mcon := range leaves {
+		found, getDiags := tableExists(ctx, mcClient, mcon.String())
+		diags.Append(getDiags...)
+		if diags.HasError() {
+			return nil, diags
+		} else if !found {
+			unresolved = append(unresolved, mcon.String())
+		}
+	}
+
+	for warehouseUuid, mcons := range containers {
+		known, listDiags := listWarehouseDatasets(ctx, mcClient, warehouseUuid)
+		diags.Append(listDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for _, mcon := range mcons {
+			if _, ok := known[mcon.Kind+mconSeparator+mcon.Path]; !ok {
+				unresolved = append(unresolved, mcon.String())
+			}
+		}
+	}
+	return unresolved, diags
+}
+
+func tableExists(ctx context.Context, mcClient client.MonteCarloClient, mcon string) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	getResult := client.GetTableState{}
+	variables := map[string]interface{}{"mcon": mcon}
+
+	if bytes, err := mcClient.ExecRaw(ctx, client.GetTableStateQuery, variables); err != nil && len(bytes) == 0 {
+		toPrint := fmt.Sprintf("MC client 'GetTable' query result - %s", err.Error())
+		diags.AddError(toPrint, "")
+		return false, diags
+	} else if jsonErr := json.Unmarshal(bytes, &getResult); jsonErr != nil {
+		toPrint := fmt.Sprintf("MC client 'GetTable' query failed to unmarshal data - %s", jsonErr.Error())
+		diags.AddError(toPrint, "")
+		return false, diags
+	}
+	table := getResult.GetTable
+	return table != nil && !table.IsDeleted && !table.IsExcluded, diags
+}
+
+// listWarehouseDatasets returns keys (`{object_type}++{object_id}`) of all projects and datasets of the warehouse.
+func listWarehouseDatasets(ctx context.Context, mcClient client.MonteCarloClient, warehouseUuid string) (map[string]struct{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	hasNextPage := true
+	known := map[string]struct{}{}
+	variables := map[string]interface{}{
+		"dwId":  client.UUID(warehouseUuid),
+		"first": 500,
+		"after": (*string)(nil),
+	}
+
+	for hasNextPage {
+		readResult := client.GetDatasets{}
+		if err := mcClient.Query(ctx, &readResult, variables); err != nil {
+			toPrint := fmt.Sprintf("MC client 'getDatasets' query result - %s", err.Error())
+			diags.AddError(toPrint, "")
+			return nil, diags
+		}
+
+		hasNextPage = readResult.GetDatasets.PageInfo.HasNextPage
+		variables["after"] = readResult.GetDatasets.PageInfo.EndCursor
+
+		for _, element := range readResult.GetDatasets.Edges {
+			project := ProjectMcon("", "", element.Node.Project)
+			dataset := DatasetMcon("", "", element.Node.Project, element.Node.Dataset)
+			known[project.Kind+mconSeparator+project.Path] = struct{}{}
+			known[dataset.Kind+mconSeparator+dataset.Path] = struct{}{}
+		}
+	}
+	return known, diags
+}
+
+func ProjectMcon(accountUuid, resourceUuid, project string) Mcon {
+	return NewMcon(accountUuid, resourceUuid, "project", project)
+}
+
+func DatasetMcon(accountUuid, resourceUuid, project, dataset string) Mcon {
+	return NewMcon(accountUuid, resourceUuid, "dataset", fmt.Sprintf("%s:%s", project, dataset))
+}
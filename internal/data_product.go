@@ -0,0 +1,269 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DataProductResource{}
+var _ resource.ResourceWithImportState = &DataProductResource{}
+
+// To simplify provider implementations, a named function can be created with the resource implementation.
+func NewDataProductResource() resource.Resource {
+	return &DataProductResource{}
+}
+
+// DataProductResource defines the resource implementation.
+type DataProductResource struct {
+	client client.MonteCarloClient
+}
+
+// DataProductResourceModel describes the resource data model according to its Schema.
+type DataProductResourceModel struct {
+	Uuid        types.String       `tfsdk:"uuid"`
+	Name        types.String       `tfsdk:"name"`
+	Description types.String       `tfsdk:"description"`
+	DomainUuid  types.String       `tfsdk:"domain_uuid"`
+	Tags        []common.TagModel  `tfsdk:"tags"`
+	Assets      []common.MconValue `tfsdk:"assets"`
+}
+
+func (r *DataProductResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_product"
+}
+
+func (r *DataProductResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Computed: true,
+				Optional: false,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"description": schema.StringAttribute{
+				Computed: true,
+				Optional: true,
+				Default:  stringdefault.StaticString(""),
+			},
+			"domain_uuid": schema.StringAttribute{
+				Optional: true,
+			},
+			"tags": schema.SetNestedAttribute{
+				Computed: true,
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+						},
+						"value": schema.StringAttribute{
+							Computed: true,
+							Optional: true,
+							Default:  stringdefault.StaticString(""),
+						},
+					},
+				},
+				Default: setdefault.StaticValue(
+					types.SetValueMust(
+						types.ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"name":  types.StringType,
+								"value": types.StringType,
+							},
+						},
+						[]attr.Value{},
+					),
+				),
+			},
+			"assets": schema.SetAttribute{
+				Computed:    true,
+				Optional:    true,
+				ElementType: common.MconType{},
+				Default: setdefault.StaticValue(
+					types.SetValueMust(
+						common.MconType{},
+						[]attr.Value{},
+					),
+				),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(common.MconValidator()),
+				},
+			},
+		},
+	}
+}
+
+func (r *DataProductResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *DataProductResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DataProductResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.validateAssets(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResult := client.CreateOrUpdateDataProduct{}
+	if err := r.client.Mutate(ctx, &createResult, r.variables(data, nil)); err != nil {
+		toPrint := fmt.Sprintf("MC client 'CreateOrUpdateDataProduct' mutation result - %s", err.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+		return
+	}
+
+	data.Uuid = types.StringValue(createResult.CreateOrUpdateDataProduct.DataProduct.Uuid)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DataProductResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DataProductResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getResult := client.GetDataProduct{}
+	variables := map[string]interface{}{"uuid": client.UUID(data.Uuid.ValueString())}
+
+	if bytes, err := r.client.ExecRaw(ctx, client.GetDataProductQuery, variables); err != nil && len(bytes) == 0 {
+		toPrint := fmt.Sprintf("MC client 'GetDataProduct' query result - %s", err.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+		return
+	} else if jsonErr := json.Unmarshal(bytes, &getResult); jsonErr != nil {
+		toPrint := fmt.Sprintf("MC client 'GetDataProduct' query failed to unmarshal data - %s", jsonErr.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+		return
+	} else if getResult.GetDataProduct == nil {
+		toPrint := fmt.Sprintf("MC client 'GetDataProduct' query failed to find data product [uuid: %s]. "+
+			"This resource will be removed from the Terraform state without deletion.", data.Uuid.ValueString())
+		if err != nil {
+			toPrint = fmt.Sprintf("%s - %s", toPrint, err.Error())
+		} // response missing data product data may or may not contain error
+		resp.Diagnostics.AddWarning(toPrint, "")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Name = types.StringValue(getResult.GetDataProduct.Name)
+	data.Description = types.StringValue(getResult.GetDataProduct.Description)
+	data.DomainUuid = types.StringPointerValue(getResult.GetDataProduct.DomainUuid)
+	data.Tags = common.FromTagPairs(getResult.GetDataProduct.Tags)
+	data.Assets = common.MconsFrom(getResult.GetDataProduct.Assets)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DataProductResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DataProductResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.validateAssets(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uuid := client.UUID(data.Uuid.ValueString())
+	updateResult := client.CreateOrUpdateDataProduct{}
+	if err := r.client.Mutate(ctx, &updateResult, r.variables(data, &uuid)); err == nil {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	} else {
+		toPrint := fmt.Sprintf("MC client 'CreateOrUpdateDataProduct' mutation result - %s", err.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+	}
+}
+
+func (r *DataProductResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DataProductResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteResult := client.DeleteDataProduct{}
+	variables := map[string]interface{}{"uuid": client.UUID(data.Uuid.ValueString())}
+
+	if err := r.client.Mutate(ctx, &deleteResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'DeleteDataProduct' mutation result - %s", err.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+	} else if !deleteResult.DeleteDataProduct.Success {
+		toPrint := "MC client 'DeleteDataProduct' mutation - success = false, " +
+			"data product probably already doesn't exists. This resource will continue with its deletion"
+		resp.Diagnostics.AddWarning(toPrint, "")
+	}
+}
+
+func (r *DataProductResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("uuid"), req, resp)
+}
+
+// validateAssets fails early if some of the assets do not resolve to existing data objects,
+// same as domain assignments (see DomainResource.validateAssignments).
+func (r *DataProductResource) validateAssets(ctx context.Context, data DataProductResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	mcons := common.MconsTo(data.Assets)
+	if len(mcons) == 0 {
+		return diags
+	}
+
+	unresolved, unresolvedDiags := common.FindUnresolvedMcons(ctx, r.client, mcons)
+	diags.Append(unresolvedDiags...)
+	if !diags.HasError() && len(unresolved) > 0 {
+		diags.AddAttributeError(path.Root("assets"),
+			fmt.Sprintf("Data product assets [%d] do not resolve to existing data objects", len(unresolved)),
+			fmt.Sprintf("Following MCONs were not found in Monte Carlo (or are deleted/excluded from collection):\n  - %s",
+				strings.Join(unresolved, "\n  - ")))
+	}
+	return diags
+}
+
+func (r *DataProductResource) variables(data DataProductResourceModel, uuid *client.UUID) map[string]interface{} {
+	var domainUuid *client.UUID
+	if !data.DomainUuid.IsNull() {
+		value := client.UUID(data.DomainUuid.ValueString())
+		domainUuid = &value
+	}
+
+	return map[string]interface{}{
+		"uuid":        uuid,
+		"name":        data.Name.ValueString(),
+		"description": data.Description.ValueString(),
+		"domainUuid":  domainUuid,
+		"tags":        common.ToTagPairs(data.Tags),
+		"assets":      common.MconsTo(data.Assets),
+	}
+}
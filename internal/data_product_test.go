@@ -0,0 +1,82 @@
+package internal_test
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDataProductResource(t *testing.T) {
+	mc_api_key_id := os.Getenv("MC_API_KEY_ID")
+	mc_api_key_token := os.Getenv("MC_API_KEY_TOKEN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{ // Create and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("create.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("montecarlo_data_product.test", "uuid"),
+					resource.TestCheckResourceAttr("montecarlo_data_product.test", "name", "data-product1"),
+					resource.TestCheckResourceAttr("montecarlo_data_product.test", "description", "Data product test description"),
+					resource.TestCheckResourceAttrPair("montecarlo_data_product.test", "domain_uuid", "montecarlo_domain.test", "uuid"),
+					resource.TestCheckResourceAttr("montecarlo_data_product.test", "assets.#", "1"),
+					resource.TestCheckResourceAttr("montecarlo_data_product.test", "tags.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("montecarlo_data_product.test", "tags.*", map[string]string{
+						"name":  "owner",
+						"value": "bi-internal",
+					}),
+				),
+			},
+			{ // ImportState testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				ResourceName:      "montecarlo_data_product.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["montecarlo_data_product.test"].Primary.Attributes["uuid"], nil
+				},
+				ImportStateVerifyIdentifierAttribute: "uuid",
+			},
+			{ // Update and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("update.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_data_product.test", "name", "data-product2"),
+					resource.TestCheckResourceAttr("montecarlo_data_product.test", "description", ""),
+					resource.TestCheckNoResourceAttr("montecarlo_data_product.test", "domain_uuid"),
+					resource.TestCheckResourceAttr("montecarlo_data_product.test", "assets.#", "2"),
+					resource.TestCheckResourceAttr("montecarlo_data_product.test", "tags.#", "0"),
+				),
+			},
+			{ // Well-formed MCON assets not resolving to existing data objects
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("unresolved_assets.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				ExpectError: regexp.MustCompile(`terraform_provider_montecarlo\.missing`),
+			},
+		},
+	})
+}
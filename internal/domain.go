@@ -4,12 +4,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/kiwicom/terraform-provider-montecarlo/client"
 	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
 
-	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -36,11 +42,22 @@ type DomainResource struct {
 
 // DomainResourceModel describes the resource data model according to its Schema.
 type DomainResourceModel struct {
-	Uuid        types.String      `tfsdk:"uuid"`
-	Name        types.String      `tfsdk:"name"`
-	Description types.String      `tfsdk:"description"`
-	Tags        []common.TagModel `tfsdk:"tags"`
-	Assignments []types.String    `tfsdk:"assignments"`
+	Uuid        types.String       `tfsdk:"uuid"`
+	Name        types.String       `tfsdk:"name"`
+	Description types.String       `tfsdk:"description"`
+	Tags        []common.TagModel  `tfsdk:"tags"`
+	Assignments []common.MconValue `tfsdk:"assignments"`
+	Rules       []DomainRuleModel  `tfsdk:"rules"`
+}
+
+// DomainRuleModel describes single rule of the domain definition. Filters of the rule are combined
+// using its operator, while the rules themselves (and top-level tags and assignments) are combined as union.
+type DomainRuleModel struct {
+	Operator    types.String       `tfsdk:"operator"`
+	Tags        []common.TagModel  `tfsdk:"tags"`
+	Assignments []common.MconValue `tfsdk:"assignments"`
+	Warehouses  []types.String     `tfsdk:"warehouses"`
+	Exclusions  []common.MconValue `tfsdk:"exclusions"`
 }
 
 func (r *DomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -84,7 +101,7 @@ func (r *DomainResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					types.SetValueMust(
 						types.ObjectType{
 							AttrTypes: map[string]attr.Type{
-								"name": types.StringType,
+								"name":  types.StringType,
 								"value": types.StringType,
 							},
 						},
@@ -95,27 +112,112 @@ func (r *DomainResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"assignments": schema.SetAttribute{
 				Computed:    true,
 				Optional:    true,
-				ElementType: types.StringType,
+				ElementType: common.MconType{},
 				Default: setdefault.StaticValue(
 					types.SetValueMust(
-						types.StringType,
+						common.MconType{},
 						[]attr.Value{},
 					),
 				),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(common.MconValidator()),
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"operator": schema.StringAttribute{
+							Computed: true,
+							Optional: true,
+							Default:  stringdefault.StaticString("OR"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("AND", "OR"),
+							},
+						},
+						"tags": schema.SetNestedAttribute{
+							Computed: true,
+							Optional: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Required: true,
+									},
+									"value": schema.StringAttribute{
+										Computed: true,
+										Optional: true,
+										Default:  stringdefault.StaticString(""),
+									},
+								},
+							},
+							Default: setdefault.StaticValue(
+								types.SetValueMust(
+									types.ObjectType{
+										AttrTypes: map[string]attr.Type{
+											"name":  types.StringType,
+											"value": types.StringType,
+										},
+									},
+									[]attr.Value{},
+								),
+							),
+						},
+						"assignments": schema.SetAttribute{
+							Computed:    true,
+							Optional:    true,
+							ElementType: common.MconType{},
+							Default: setdefault.StaticValue(
+								types.SetValueMust(
+									common.MconType{},
+									[]attr.Value{},
+								),
+							),
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(common.MconValidator()),
+							},
+						},
+						"warehouses": schema.SetAttribute{
+							Computed:    true,
+							Optional:    true,
+							ElementType: types.StringType,
+							Default: setdefault.StaticValue(
+								types.SetValueMust(
+									types.StringType,
+									[]attr.Value{},
+								),
+							),
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(common.UuidValidator()),
+							},
+						},
+						"exclusions": schema.SetAttribute{
+							Computed:    true,
+							Optional:    true,
+							ElementType: common.MconType{},
+							Default: setdefault.StaticValue(
+								types.SetValueMust(
+									common.MconType{},
+									[]attr.Value{},
+								),
+							),
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(common.MconValidator()),
+							},
+						},
+					},
+					Validators: []validator.Object{
+						objectvalidator.AtLeastOneOf(
+							path.MatchRelative().AtName("tags"),
+							path.MatchRelative().AtName("assignments"),
+							path.MatchRelative().AtName("warehouses"),
+						),
+					},
+				},
 			},
 		},
 	}
 }
 
-func (r *DomainResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
-	return []resource.ConfigValidator{
-		resourcevalidator.Conflicting(
-			path.MatchRoot("assignments"),
-			path.MatchRoot("tags"),
-		),
-	}
-}
-
 func (r *DomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	client, diags := common.Configure(req)
 	resp.Diagnostics.Append(diags...)
@@ -129,11 +231,17 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(r.validateAssignments(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createResult := client.CreateOrUpdateDomain{}
 	variables := map[string]interface{}{
 		"uuid":        (*client.UUID)(nil),
-		"assignments": common.TfStringsTo[string](data.Assignments),
+		"assignments": common.MconsTo(data.Assignments),
 		"tags":        common.ToTagPairs(data.Tags),
+		"domainRules": toDomainRuleInputs(data.Rules),
 		"name":        data.Name.ValueString(),
 		"description": data.Description.ValueString(),
 	}
@@ -178,7 +286,10 @@ func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	data.Tags = common.FromTagPairs(getResult.GetDomain.Tags)
-	data.Assignments = common.TfStringsFrom(getResult.GetDomain.Assignments)
+	data.Assignments = common.MconsFrom(getResult.GetDomain.Assignments)
+	if len(getResult.GetDomain.DomainRules) > 0 || data.Rules != nil {
+		data.Rules = fromDomainRuleOutputs(getResult.GetDomain.DomainRules)
+	}
 	data.Name = types.StringValue(getResult.GetDomain.Name)
 	data.Description = types.StringValue(getResult.GetDomain.Description)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -191,11 +302,17 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(r.validateAssignments(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createResult := client.CreateOrUpdateDomain{}
 	variables := map[string]interface{}{
 		"uuid":        client.UUID(data.Uuid.ValueString()),
-		"assignments": common.TfStringsTo[string](data.Assignments),
+		"assignments": common.MconsTo(data.Assignments),
 		"tags":        common.ToTagPairs(data.Tags),
+		"domainRules": toDomainRuleInputs(data.Rules),
 		"name":        data.Name.ValueString(),
 		"description": data.Description.ValueString(),
 	}
@@ -232,3 +349,76 @@ func (r *DomainResource) Delete(ctx context.Context, req resource.DeleteRequest,
 func (r *DomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("uuid"), req, resp)
 }
+
+// validateAssignments fails early if some of the assignments (including assignments of the rules) do not
+// resolve to existing data objects, since Monte Carlo API either rejects them with confusing error or silently
+// creates an empty domain. Exclusions of the rules are not validated, so that tables can be excluded in advance.
+func (r *DomainResource) validateAssignments(ctx context.Context, data DomainResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	attributes := []path.Path{path.Root("assignments")}
+	assignments := [][]string{common.MconsTo(data.Assignments)}
+	for i, rule := range data.Rules {
+		attributes = append(attributes, path.Root("rules").AtListIndex(i).AtName("assignments"))
+		assignments = append(assignments, common.MconsTo(rule.Assignments))
+	}
+
+	mcons := []string{}
+	for _, element := range assignments {
+		mcons = append(mcons, element...)
+	}
+
+	if len(mcons) == 0 {
+		return diags
+	}
+
+	unresolved, unresolvedDiags := common.FindUnresolvedMcons(ctx, r.client, mcons)
+	diags.Append(unresolvedDiags...)
+	if diags.HasError() || len(unresolved) == 0 {
+		return diags
+	}
+
+	for i, attribute := range attributes {
+		attributeUnresolved := []string{}
+		for _, mcon := range assignments[i] {
+			if slices.Contains(unresolved, mcon) {
+				attributeUnresolved = append(attributeUnresolved, mcon)
+			}
+		}
+
+		if len(attributeUnresolved) > 0 {
+			diags.AddAttributeError(attribute,
+				fmt.Sprintf("Domain assignments [%d] do not resolve to existing data objects", len(attributeUnresolved)),
+				fmt.Sprintf("Following MCONs were not found in Monte Carlo (or are deleted/excluded from collection):\n  - %s",
+					strings.Join(attributeUnresolved, "\n  - ")))
+		}
+	}
+	return diags
+}
+
+func toDomainRuleInputs(in []DomainRuleModel) []client.DomainRuleInput {
+	rules := make([]client.DomainRuleInput, 0, len(in))
+	for _, element := range in {
+		rules = append(rules, client.DomainRuleInput{
+			Operator:       element.Operator.ValueString(),
+			Tags:           common.ToTagPairs(element.Tags),
+			Assignments:    common.MconsTo(element.Assignments),
+			WarehouseUuids: common.TfStringsTo[client.UUID](element.Warehouses),
+			Exclusions:     common.MconsTo(element.Exclusions),
+		})
+	}
+	return rules
+}
+
+func fromDomainRuleOutputs(in []client.DomainRuleOutput) []DomainRuleModel {
+	rules := make([]DomainRuleModel, 0, len(in))
+	for _, element := range in {
+		rules = append(rules, DomainRuleModel{
+			Operator:    types.StringValue(element.Operator),
+			Tags:        common.FromTagPairs(element.Tags),
+			Assignments: common.MconsFrom(element.Assignments),
+			Warehouses:  common.TfStringsFrom(element.WarehouseUuids),
+			Exclusions:  common.MconsFrom(element.Exclusions),
+		})
+	}
+	return rules
+}
@@ -0,0 +1,237 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DomainDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &DomainDataSource{}
+
+func NewDomainDatasource() datasource.DataSource {
+	return &DomainDataSource{}
+}
+
+type DomainDataSource struct {
+	client client.MonteCarloClient
+}
+
+type DomainDataSourceModel struct {
+	Uuid           types.String                `tfsdk:"uuid"`
+	Name           types.String                `tfsdk:"name"`
+	Description    types.String                `tfsdk:"description"`
+	CreatedByEmail types.String                `tfsdk:"created_by_email"`
+	Tags           []common.TagModel           `tfsdk:"tags"`
+	Assignments    []types.String              `tfsdk:"assignments"`
+	Rules          []DomainRuleDataSourceModel `tfsdk:"rules"`
+}
+
+type DomainRuleDataSourceModel struct {
+	Operator    types.String      `tfsdk:"operator"`
+	Tags        []common.TagModel `tfsdk:"tags"`
+	Assignments []types.String    `tfsdk:"assignments"`
+	Warehouses  []types.String    `tfsdk:"warehouses"`
+	Exclusions  []types.String    `tfsdk:"exclusions"`
+}
+
+func NewDomainDataSourceModel(in client.Domain) DomainDataSourceModel {
+	result := DomainDataSourceModel{
+		Uuid:           types.StringValue(in.Uuid),
+		Name:           types.StringValue(in.Name),
+		Description:    types.StringValue(in.Description),
+		CreatedByEmail: types.StringValue(in.CreatedByEmail),
+		Tags:           common.FromTagPairs(in.Tags),
+		Assignments:    common.TfStringsFrom(in.Assignments),
+		Rules:          make([]DomainRuleDataSourceModel, 0, len(in.DomainRules)),
+	}
+	for _, rule := range in.DomainRules {
+		result.Rules = append(result.Rules, DomainRuleDataSourceModel{
+			Operator:    types.StringValue(rule.Operator),
+			Tags:        common.FromTagPairs(rule.Tags),
+			Assignments: common.TfStringsFrom(rule.Assignments),
+			Warehouses:  common.TfStringsFrom(rule.WarehouseUuids),
+			Exclusions:  common.TfStringsFrom(rule.Exclusions),
+		})
+	}
+	return result
+}
+
+func (d *DomainDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain"
+}
+
+func (d *DomainDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Computed: true,
+				Optional: true,
+			},
+			"name": schema.StringAttribute{
+				Computed: true,
+				Optional: true,
+			},
+			"description": schema.StringAttribute{
+				Computed: true,
+			},
+			"created_by_email": schema.StringAttribute{
+				Computed: true,
+			},
+			"tags": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"value": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"assignments": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"rules": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"operator": schema.StringAttribute{
+							Computed: true,
+						},
+						"tags": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Computed: true,
+									},
+									"value": schema.StringAttribute{
+										Computed: true,
+									},
+								},
+							},
+						},
+						"assignments": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"warehouses": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"exclusions": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DomainDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("uuid"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *DomainDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	d.client = client
+}
+
+func (d *DomainDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DomainDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var found *client.Domain
+	if !data.Uuid.IsNull() {
+		getResult := client.GetDomain{}
+		variables := map[string]interface{}{"uuid": client.UUID(data.Uuid.ValueString())}
+
+		if bytes, err := d.client.ExecRaw(ctx, client.GetDomainQuery, variables); err != nil && len(bytes) == 0 {
+			toPrint := fmt.Sprintf("MC client 'GetDomain' query result - %s", err.Error())
+			resp.Diagnostics.AddError(toPrint, "")
+			return
+		} else if jsonErr := json.Unmarshal(bytes, &getResult); jsonErr != nil {
+			toPrint := fmt.Sprintf("MC client 'GetDomain' query failed to unmarshal data - %s", jsonErr.Error())
+			resp.Diagnostics.AddError(toPrint, "")
+			return
+		} else if getResult.GetDomain == nil {
+			toPrint := fmt.Sprintf("MC client 'GetDomain' query failed to find domain [uuid: %s]", data.Uuid.ValueString())
+			if err != nil {
+				toPrint = fmt.Sprintf("%s - %s", toPrint, err.Error())
+			} // response missing domain data may or may not contain error
+			resp.Diagnostics.AddError(toPrint, "")
+			return
+		}
+		found = getResult.GetDomain
+	} else {
+		domains, diags := getAllDomains(ctx, d.client)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for _, domain := range domains {
+			if domain.Name != data.Name.ValueString() {
+				continue
+			} else if found != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("Multiple domains found [name: %s]", data.Name.ValueString()),
+					"Domain names are not guaranteed to be unique in Monte Carlo, use 'uuid' to look up this domain instead.")
+				return
+			}
+			found = &domain
+		}
+
+		if found == nil {
+			toPrint := fmt.Sprintf("MC client 'GetAllDomains' query failed to find domain [name: %s]", data.Name.ValueString())
+			resp.Diagnostics.AddError(toPrint, "")
+			return
+		}
+	}
+
+	data = NewDomainDataSourceModel(*found)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func getAllDomains(ctx context.Context, mcClient client.MonteCarloClient) ([]client.Domain, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	getResult := client.GetAllDomains{}
+	if bytes, err := mcClient.ExecRaw(ctx, client.GetAllDomainsQuery, map[string]interface{}{}); err != nil && len(bytes) == 0 {
+		toPrint := fmt.Sprintf("MC client 'GetAllDomains' query result - %s", err.Error())
+		diags.AddError(toPrint, "")
+		return nil, diags
+	} else if jsonErr := json.Unmarshal(bytes, &getResult); jsonErr != nil {
+		toPrint := fmt.Sprintf("MC client 'GetAllDomains' query failed to unmarshal data - %s", jsonErr.Error())
+		diags.AddError(toPrint, "")
+		return nil, diags
+	} else if err != nil {
+		toPrint := fmt.Sprintf("MC client 'GetAllDomains' query result - %s", err.Error())
+		diags.AddError(toPrint, "")
+		return nil, diags
+	}
+	return getResult.GetAllDomains, diags
+}
@@ -0,0 +1,42 @@
+package internal_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDomainDataSource(t *testing.T) {
+	mc_api_key_id := os.Getenv("MC_API_KEY_ID")
+	mc_api_key_token := os.Getenv("MC_API_KEY_TOKEN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{ // Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("read.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.montecarlo_domain.by_uuid", "uuid", "montecarlo_domain.test", "uuid"),
+					resource.TestCheckResourceAttr("data.montecarlo_domain.by_uuid", "name", "TestAccDomainDataSource"),
+					resource.TestCheckResourceAttr("data.montecarlo_domain.by_uuid", "description", "Domain data source test description"),
+					resource.TestCheckResourceAttr("data.montecarlo_domain.by_uuid", "assignments.#", "0"),
+					resource.TestCheckResourceAttr("data.montecarlo_domain.by_uuid", "tags.#", "1"),
+					resource.TestCheckResourceAttr("data.montecarlo_domain.by_uuid", "tags.0.name", "owner"),
+					resource.TestCheckResourceAttr("data.montecarlo_domain.by_uuid", "tags.0.value", "bi-internal"),
+					resource.TestCheckResourceAttrPair("data.montecarlo_domain.by_name", "uuid", "montecarlo_domain.test", "uuid"),
+					resource.TestCheckResourceAttr("data.montecarlo_domain.by_name", "name", "TestAccDomainDataSource"),
+					resource.TestCheckResourceAttr("data.montecarlo_domain.by_name", "description", "Domain data source test description"),
+				),
+			},
+		},
+	})
+}
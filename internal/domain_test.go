@@ -2,6 +2,7 @@ package internal_test
 
 import (
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/kiwicom/terraform-provider-montecarlo/internal/acctest"
@@ -87,6 +88,45 @@ func TestAccDomainResource(t *testing.T) {
 					resource.TestCheckResourceAttr("montecarlo_domain.test", "tags.#", "0"),
 				),
 			},
+			{ // Update with combined tags and domain rules
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("update_rules.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_domain.test", "name", "domain2"),
+					resource.TestCheckResourceAttr("montecarlo_domain.test", "assignments.#", "0"),
+					resource.TestCheckResourceAttr("montecarlo_domain.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("montecarlo_domain.test", "rules.#", "2"),
+					resource.TestCheckResourceAttr("montecarlo_domain.test", "rules.0.operator", "AND"),
+					resource.TestCheckResourceAttr("montecarlo_domain.test", "rules.0.tags.#", "1"),
+					resource.TestCheckResourceAttr("montecarlo_domain.test", "rules.0.assignments.#", "1"),
+					resource.TestCheckResourceAttr("montecarlo_domain.test", "rules.0.exclusions.#", "1"),
+					resource.TestCheckResourceAttr("montecarlo_domain.test", "rules.0.warehouses.#", "0"),
+					resource.TestCheckResourceAttr("montecarlo_domain.test", "rules.1.operator", "OR"),
+					resource.TestCheckResourceAttr("montecarlo_domain.test", "rules.1.assignments.#", "1"),
+				),
+			},
+			{ // Malformed MCON assignments
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("invalid_assignments.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				ExpectError: regexp.MustCompile(`Invalid MCON`),
+			},
+			{ // Well-formed MCON assignments not resolving to existing data objects
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("unresolved_assignments.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				ExpectError: regexp.MustCompile(`terraform_provider_montecarlo\.missing`),
+			},
 		},
 	})
 }
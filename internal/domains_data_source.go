@@ -0,0 +1,134 @@
+package internal
+
+import (
+	"context"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DomainsDataSource{}
+
+func NewDomainsDatasource() datasource.DataSource {
+	return &DomainsDataSource{}
+}
+
+type DomainsDataSource struct {
+	client client.MonteCarloClient
+}
+
+type DomainsDataSourceModel struct {
+	Domains []DomainDataSourceModel `tfsdk:"domains"`
+}
+
+func (d *DomainsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domains"
+}
+
+func (d *DomainsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"domains": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uuid": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+						"created_by_email": schema.StringAttribute{
+							Computed: true,
+						},
+						"tags": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Computed: true,
+									},
+									"value": schema.StringAttribute{
+										Computed: true,
+									},
+								},
+							},
+						},
+						"assignments": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"rules": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"operator": schema.StringAttribute{
+										Computed: true,
+									},
+									"tags": schema.ListNestedAttribute{
+										Computed: true,
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"name": schema.StringAttribute{
+													Computed: true,
+												},
+												"value": schema.StringAttribute{
+													Computed: true,
+												},
+											},
+										},
+									},
+									"assignments": schema.SetAttribute{
+										Computed:    true,
+										ElementType: types.StringType,
+									},
+									"warehouses": schema.SetAttribute{
+										Computed:    true,
+										ElementType: types.StringType,
+									},
+									"exclusions": schema.SetAttribute{
+										Computed:    true,
+										ElementType: types.StringType,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DomainsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	d.client = client
+}
+
+func (d *DomainsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DomainsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domains, diags := getAllDomains(ctx, d.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Domains = make([]DomainDataSourceModel, 0, len(domains))
+	for _, domain := range domains {
+		data.Domains = append(data.Domains, NewDomainDataSourceModel(domain))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
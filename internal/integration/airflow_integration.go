@@ -0,0 +1,336 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AirflowIntegrationResource{}
+var _ resource.ResourceWithImportState = &AirflowIntegrationResource{}
+var _ resource.ResourceWithConfigValidators = &AirflowIntegrationResource{}
+var _ resource.ResourceWithModifyPlan = &AirflowIntegrationResource{}
+
+// To simplify provider implementations, a named function can be created with the resource implementation.
+func NewAirflowIntegrationResource() resource.Resource {
+	return &AirflowIntegrationResource{}
+}
+
+// AirflowIntegrationResource defines the resource implementation.
+type AirflowIntegrationResource struct {
+	client client.MonteCarloClient
+}
+
+// AirflowIntegrationResourceModel describes the resource data model according to its Schema.
+type AirflowIntegrationResourceModel struct {
+	WarehouseUuid      types.String       `tfsdk:"warehouse_uuid"`
+	CollectorUuid      types.String       `tfsdk:"collector_uuid"`
+	Credentials        AirflowCredentials `tfsdk:"credentials"`
+	CallbackTokenId    types.String       `tfsdk:"callback_token_id"`
+	CallbackToken      types.String       `tfsdk:"callback_token"`
+	CallbackKeepers    types.Map          `tfsdk:"callback_token_keepers"`
+	DeletionProtection types.Bool         `tfsdk:"deletion_protection"`
+	CredentialsDrifted types.Bool         `tfsdk:"credentials_drifted"`
+}
+
+type AirflowCredentials struct {
+	ConnectionUuid types.String `tfsdk:"connection_uuid"`
+	HostName       types.String `tfsdk:"host_name"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+}
+
+func (m AirflowIntegrationResourceModel) GetCollectorUuid() types.String { return m.CollectorUuid }
+func (m AirflowIntegrationResourceModel) GetWarehouseUuid() types.String { return m.WarehouseUuid }
+func (m AirflowIntegrationResourceModel) GetConnectionUuid() types.String {
+	return m.Credentials.ConnectionUuid
+}
+
+func (r *AirflowIntegrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_airflow_integration"
+}
+
+func (r *AirflowIntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"warehouse_uuid": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"collector_uuid": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"credentials": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"connection_uuid": schema.StringAttribute{
+						Computed: true,
+						Optional: false,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"host_name": schema.StringAttribute{
+						Required: true,
+					},
+					"username": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+					"password": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+					"updated_at": schema.StringAttribute{
+						Computed: true,
+						Optional: false,
+					},
+				},
+			},
+			"callback_token_id": schema.StringAttribute{
+				Computed: true,
+				Optional: false,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"callback_token": schema.StringAttribute{
+				Computed:  true,
+				Optional:  false,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"callback_token_keepers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"credentials_drifted": schema.BoolAttribute{
+				Computed: true,
+				Optional: false,
+				PlanModifiers: []planmodifier.Bool{
+					common.RotateCredentialsIfDrifted(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AirflowIntegrationResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	credentials := path.MatchRoot("credentials")
+	return []resource.ConfigValidator{
+		resourcevalidator.RequiredTogether(credentials.AtName("username"), credentials.AtName("password")),
+	}
+}
+
+func (r *AirflowIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *AirflowIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AirflowIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := addEtlConnection(ctx, r.client, r, data, client.AirflowConnectionType, []string{"airflow"}, AirflowKeyExtractor)
+	resp.Diagnostics.Append(diags...)
+	if result == nil {
+		return
+	}
+
+	data.Credentials.UpdatedAt = types.StringValue(result.AddConnection.Connection.CreatedOn)
+	data.Credentials.ConnectionUuid = types.StringValue(result.AddConnection.Connection.Uuid)
+	data.CredentialsDrifted = types.BoolValue(false)
+	data.CallbackTokenId = types.StringNull()
+	data.CallbackToken = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.createCallbackKey(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AirflowIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AirflowIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readUpdatedAt, diags := readWarehouseConnection(ctx, r.client, data.WarehouseUuid, data.CollectorUuid,
+		data.Credentials.ConnectionUuid, client.AirflowConnectionTypeResponse)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	} else if readUpdatedAt.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.CredentialsDrifted = common.CredentialsDrifted(data.CredentialsDrifted, data.Credentials.UpdatedAt, readUpdatedAt)
+	data.Credentials.UpdatedAt = readUpdatedAt
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AirflowIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state AirflowIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// credentials are rotated only when changed, callback key can be rotated on its own
+	if state.CredentialsDrifted.ValueBool() || !data.Credentials.HostName.Equal(state.Credentials.HostName) ||
+		!data.Credentials.Username.Equal(state.Credentials.Username) || !data.Credentials.Password.Equal(state.Credentials.Password) {
+		updateResult, diags := updateConnection(ctx, r.client, r, data, AirflowKeyExtractor)
+		resp.Diagnostics.Append(diags...)
+		if updateResult == nil {
+			return
+		}
+		data.Credentials.UpdatedAt = types.StringValue(updateResult.UpdateCredentialsV2.UpdatedAt)
+	} else {
+		data.Credentials.UpdatedAt = state.Credentials.UpdatedAt
+	}
+	data.CredentialsDrifted = types.BoolValue(false)
+
+	// rotation - new callback key is created before the old one is deleted
+	rotate := data.CallbackTokenId.IsUnknown()
+	if rotate {
+		resp.Diagnostics.Append(r.createCallbackKey(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if rotate && !state.CallbackTokenId.IsNull() {
+		resp.Diagnostics.Append(r.deleteCallbackKey(ctx, state.CallbackTokenId)...)
+	}
+}
+
+func (r *AirflowIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AirflowIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(removeConnection(ctx, r.client, data.DeletionProtection, data.Credentials.ConnectionUuid)...)
+	if resp.Diagnostics.HasError() || data.CallbackTokenId.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.deleteCallbackKey(ctx, data.CallbackTokenId)...)
+}
+
+func (r *AirflowIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importEtlIntegration(ctx, req, resp)
+	resp.Diagnostics.AddWarning("Imported Airflow integration does not contain callback token",
+		"Callback token cannot be read back from Monte Carlo. Existing token used by Airflow callbacks is not managed by this resource. "+
+			"Set 'callback_token_keepers' to create a new callback token by the next apply.")
+}
+
+// ModifyPlan plans rotation of the callback key (callback_token_id and callback_token become unknown)
+// when callback_token_keepers change. Imported resources get their first managed key the same way.
+func (r *AirflowIntegrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return // nothing to rotate during creation or deletion
+	}
+
+	var plan, state AirflowIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.CallbackKeepers.Equal(state.CallbackKeepers) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("callback_token_id"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("callback_token"), types.StringUnknown())...)
+	}
+}
+
+// createCallbackKey creates integration key authenticating Airflow callbacks (DAG and task results reported
+// to Monte Carlo). The key is scoped to Airflow callbacks of the integrated warehouse only.
+func (r *AirflowIntegrationResource) createCallbackKey(ctx context.Context, data *AirflowIntegrationResourceModel) diag.Diagnostics {
+	var diagsResult diag.Diagnostics
+	createResult := client.CreateIntegrationKey{}
+	variables := map[string]interface{}{
+		"description":  fmt.Sprintf("Airflow callbacks [connection_uuid: %s]", data.Credentials.ConnectionUuid.ValueString()),
+		"scope":        client.AirflowCallbacksKeyScope,
+		"warehouseIds": []client.UUID{client.UUID(data.WarehouseUuid.ValueString())},
+	}
+
+	if err := r.client.Mutate(ctx, &createResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'CreateIntegrationKey' mutation result - %s", err.Error())
+		diagsResult.AddError(toPrint, "")
+		return diagsResult
+	}
+
+	data.CallbackTokenId = types.StringValue(createResult.CreateIntegrationKey.Key.Id)
+	data.CallbackToken = types.StringValue(createResult.CreateIntegrationKey.Key.Secret)
+	return diagsResult
+}
+
+func (r *AirflowIntegrationResource) deleteCallbackKey(ctx context.Context, keyId types.String) diag.Diagnostics {
+	var diagsResult diag.Diagnostics
+	deleteResult := client.DeleteIntegrationKey{}
+	variables := map[string]interface{}{"keyId": keyId.ValueString()}
+	if err := r.client.Mutate(ctx, &deleteResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'DeleteIntegrationKey' mutation result - %s", err.Error())
+		diagsResult.AddError(toPrint, "")
+	} else if !deleteResult.DeleteIntegrationKey.Deleted {
+		toPrint := "MC client 'DeleteIntegrationKey' mutation - deleted = false, " +
+			"callback key probably already doesn't exists. This resource will continue with its deletion"
+		diagsResult.AddWarning(toPrint, "")
+	}
+	return diagsResult
+}
+
+func (r *AirflowIntegrationResource) testCredentials(ctx context.Context, data AirflowIntegrationResourceModel) (*client.TestAirflowCredentials, diag.Diagnostics) {
+	var diagsResult diag.Diagnostics
+	testResult := client.TestAirflowCredentials{}
+	variables := map[string]interface{}{
+		"hostName": data.Credentials.HostName.ValueString(),
+		"username": data.Credentials.Username.ValueStringPointer(),
+		"password": data.Credentials.Password.ValueStringPointer(),
+	}
+
+	if err := r.client.Mutate(ctx, &testResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'TestAirflowCredentials' mutation result - %s", err.Error())
+		diagsResult.AddError(toPrint, "")
+		return nil, diagsResult
+	} else if !testResult.TestAirflowCredentials.Success {
+		diagsResult.AddAttributeError(credentialsPath, "MC client 'TestAirflowCredentials' mutation - success = false, "+
+			"Monte Carlo failed to connect to Airflow with provided credentials.", "")
+		return nil, diagsResult
+	}
+	return &testResult, diagsResult
+}
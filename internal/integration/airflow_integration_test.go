@@ -0,0 +1,98 @@
+package integration_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccAirflowIntegrationResource(t *testing.T) {
+	t.Skip("Currently ignored due to dependency on live Airflow instance")
+
+	mc_api_key_id := os.Getenv("MC_API_KEY_ID")
+	mc_api_key_token := os.Getenv("MC_API_KEY_TOKEN")
+	warehouseUuid := "da6c0716-2724-4bfc-b5cc-7e0364faf979"
+	collectorUuid := "9d1aee0a-6a90-47f0-8221-a884be707fc4"
+
+	airflowHostName := os.Getenv("AIRFLOW_HOST_NAME")
+	airflowUsername := os.Getenv("AIRFLOW_USERNAME")
+	airflowPassword := os.Getenv("AIRFLOW_PASSWORD")
+
+	if airflowHostName == "" {
+		t.Fatalf("'AIRFLOW_HOST_NAME' must be set for this acceptance tests")
+	} else if airflowUsername == "" {
+		t.Fatalf("'AIRFLOW_USERNAME' must be set for this acceptance tests")
+	} else if airflowPassword == "" {
+		t.Fatalf("'AIRFLOW_PASSWORD' must be set for this acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{ // Create and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("create.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+					"airflow_host_name":        config.StringVariable(airflowHostName),
+					"airflow_username":         config.StringVariable(airflowUsername),
+					"airflow_password":         config.StringVariable(airflowPassword),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_airflow_integration.test", "warehouse_uuid", warehouseUuid),
+					resource.TestCheckResourceAttr("montecarlo_airflow_integration.test", "collector_uuid", collectorUuid),
+					resource.TestCheckResourceAttr("montecarlo_airflow_integration.test", "deletion_protection", "false"),
+					resource.TestCheckResourceAttr("montecarlo_airflow_integration.test", "credentials_drifted", "false"),
+					resource.TestCheckResourceAttrSet("montecarlo_airflow_integration.test", "credentials.connection_uuid"),
+					resource.TestCheckResourceAttr("montecarlo_airflow_integration.test", "credentials.host_name", airflowHostName),
+					resource.TestCheckResourceAttr("montecarlo_airflow_integration.test", "credentials.username", airflowUsername),
+					resource.TestCheckResourceAttr("montecarlo_airflow_integration.test", "credentials.password", airflowPassword),
+					resource.TestCheckResourceAttrSet("montecarlo_airflow_integration.test", "callback_token_id"),
+					resource.TestCheckResourceAttrSet("montecarlo_airflow_integration.test", "callback_token"),
+				),
+			},
+			{ // Callback token rotation testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("rotate.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+					"airflow_host_name":        config.StringVariable(airflowHostName),
+					"airflow_username":         config.StringVariable(airflowUsername),
+					"airflow_password":         config.StringVariable(airflowPassword),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_airflow_integration.test", "callback_token_keepers.rotated", "1"),
+					resource.TestCheckResourceAttrSet("montecarlo_airflow_integration.test", "callback_token_id"),
+					resource.TestCheckResourceAttrSet("montecarlo_airflow_integration.test", "callback_token"),
+				),
+			},
+			{ // ImportState testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+					"airflow_host_name":        config.StringVariable(airflowHostName),
+					"airflow_username":         config.StringVariable(airflowUsername),
+					"airflow_password":         config.StringVariable(airflowPassword),
+				},
+				ResourceName:      "montecarlo_airflow_integration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					connectionUuid := s.RootModule().Resources["montecarlo_airflow_integration.test"].Primary.Attributes["credentials.connection_uuid"]
+					return fmt.Sprintf("%[1]s,%[2]s,%[3]s", warehouseUuid, connectionUuid, collectorUuid), nil
+				},
+				ImportStateVerifyIdentifierAttribute: "warehouse_uuid",
+				ImportStateVerifyIgnore:              []string{"deletion_protection", "credentials", "callback_token_id", "callback_token", "callback_token_keepers"},
+			},
+		},
+	})
+}
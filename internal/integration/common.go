@@ -0,0 +1,296 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// credentialsPath is used for diagnostics of failed credentials tests.
+var credentialsPath = path.Root("credentials")
+
+// IntegrationResource is shared by integrations following the same test-then-add flow with temporary
+// credentials key, as used by warehouse resources.
+type IntegrationResource[T IntegrationResourceModel, K TestCredentials] interface {
+	*LookerIntegrationResource | *TableauIntegrationResource | *PowerBiIntegrationResource |
+		*AirflowIntegrationResource | *FivetranIntegrationResource | *DatabricksJobsIntegrationResource |
+		*DbtCloudIntegrationResource
+	testCredentials(ctx context.Context, data T) (K, diag.Diagnostics)
+}
+
+type IntegrationResourceModel interface {
+	LookerIntegrationResourceModel | TableauIntegrationResourceModel | PowerBiIntegrationResourceModel |
+		AirflowIntegrationResourceModel | FivetranIntegrationResourceModel | DatabricksJobsIntegrationResourceModel |
+		DbtCloudIntegrationResourceModel
+	GetCollectorUuid() types.String
+	GetConnectionUuid() types.String
+}
+
+type BiIntegrationResourceModel interface {
+	LookerIntegrationResourceModel | TableauIntegrationResourceModel | PowerBiIntegrationResourceModel
+	GetCollectorUuid() types.String
+	GetName() types.String
+	GetConnectionUuid() types.String
+}
+
+type EtlIntegrationResourceModel interface {
+	AirflowIntegrationResourceModel | FivetranIntegrationResourceModel | DatabricksJobsIntegrationResourceModel |
+		DbtCloudIntegrationResourceModel
+	GetCollectorUuid() types.String
+	GetWarehouseUuid() types.String
+	GetConnectionUuid() types.String
+}
+
+type TestCredentials interface {
+	*client.TestLookerCredentials | *client.TestTableauCredentials | *client.TestPowerBiCredentials |
+		*client.TestAirflowCredentials | *client.TestFivetranCredentials | *client.TestDatabricksJobsCredentials |
+		*client.TestDbtCloudCredentials
+}
+
+func LookerKeyExtractor(k *client.TestLookerCredentials) string {
+	return k.TestLookerCredentials.Key
+}
+func TableauKeyExtractor(k *client.TestTableauCredentials) string {
+	return k.TestTableauCredentials.Key
+}
+func PowerBiKeyExtractor(k *client.TestPowerBiCredentials) string {
+	return k.TestPowerBiCredentials.Key
+}
+func AirflowKeyExtractor(k *client.TestAirflowCredentials) string {
+	return k.TestAirflowCredentials.Key
+}
+func FivetranKeyExtractor(k *client.TestFivetranCredentials) string {
+	return k.TestFivetranCredentials.Key
+}
+func DatabricksJobsKeyExtractor(k *client.TestDatabricksJobsCredentials) string {
+	return k.TestDatabricksJobsCredentials.Key
+}
+func DbtCloudKeyExtractor(k *client.TestDbtCloudCredentials) string {
+	return k.TestDbtCloudCredentials.Key
+}
+
+func addBiConnection[T IntegrationResource[J, K], J BiIntegrationResourceModel, K TestCredentials](
+	ctx context.Context, mcClient client.MonteCarloClient, integration T, data J, connectionType string, keyExtractor func(K) string,
+) (*client.AddBiConnection, diag.Diagnostics) {
+	var diagsResult diag.Diagnostics
+	testResult, credentialsDiags := integration.testCredentials(ctx, data)
+	diagsResult.Append(credentialsDiags...)
+	if testResult == nil {
+		return nil, diagsResult
+	}
+
+	addResult := client.AddBiConnection{}
+	variables := map[string]interface{}{
+		"connectionType": connectionType,
+		"dcId":           client.UUID(data.GetCollectorUuid().ValueString()),
+		"key":            keyExtractor(testResult),
+		"name":           data.GetName().ValueString(),
+	}
+
+	if err := mcClient.Mutate(ctx, &addResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'AddBiConnection' mutation result - %s", err.Error())
+		diagsResult.AddError(toPrint, "")
+		return nil, diagsResult
+	} else {
+		return &addResult, diagsResult
+	}
+}
+
+// addEtlConnection adds connection of the ETL/orchestration tool to the existing warehouse.
+func addEtlConnection[T IntegrationResource[J, K], J EtlIntegrationResourceModel, K TestCredentials](
+	ctx context.Context, mcClient client.MonteCarloClient, integration T, data J, connectionType string, jobTypes []string, keyExtractor func(K) string,
+) (*client.AddConnection, diag.Diagnostics) {
+	variables := map[string]interface{}{
+		"dcId":                (*client.UUID)(data.GetCollectorUuid().ValueStringPointer()),
+		"dwId":                client.UUID(data.GetWarehouseUuid().ValueString()),
+		"jobTypes":            jobTypes,
+		"name":                (*string)(nil),
+		"connectionType":      connectionType,
+		"createWarehouseType": (*string)(nil),
+	}
+
+	test := func(ctx context.Context) (K, diag.Diagnostics) { return integration.testCredentials(ctx, data) }
+	return common.AddConnection(ctx, mcClient, test, keyExtractor, variables)
+}
+
+func updateConnection[T IntegrationResource[J, K], J IntegrationResourceModel, K TestCredentials](
+	ctx context.Context, mcClient client.MonteCarloClient, integration T, data J, keyExtractor func(K) string,
+) (*client.UpdateCredentialsV2, diag.Diagnostics) {
+	test := func(ctx context.Context) (K, diag.Diagnostics) { return integration.testCredentials(ctx, data) }
+	return common.UpdateConnection(ctx, mcClient, test, keyExtractor, data.GetConnectionUuid())
+}
+
+// readBiConnection finds BI container and its connection managed by the integration resource. If any of
+// them is missing, or the container was moved to other Data Collector, nil is returned together with
+// a warning and the resource is expected to be removed from the Terraform state without deletion.
+func readBiConnection(ctx context.Context, mcClient client.MonteCarloClient, uuid, collectorUuid, connectionUuid, connectionType string,
+) (*client.BiContainer, *client.BiConnection, diag.Diagnostics) {
+	var diagsResult diag.Diagnostics
+	getResult := client.GetBiContainers{}
+	if err := mcClient.Query(ctx, &getResult, map[string]interface{}{}); err != nil {
+		toPrint := fmt.Sprintf("MC client 'GetBiContainers' query result - %s", err.Error())
+		diagsResult.AddError(toPrint, "")
+		return nil, nil, diagsResult
+	}
+
+	for _, container := range getResult.GetUser.Account.Bi {
+		if container.Uuid != uuid {
+			continue
+		}
+
+		if container.DataCollector.Uuid != collectorUuid {
+			diagsResult.AddWarning(fmt.Sprintf("Obtained BI integration with [uuid: %s] but its Data "+
+				"Collector UUID does not match with configured value [obtained: %s, configured: %s]. BI integration "+
+				"might have been moved to other Data Collector externally. This resource will be removed "+
+				"from the Terraform state without deletion.",
+				uuid, container.DataCollector.Uuid, collectorUuid), "")
+			return nil, nil, diagsResult
+		}
+
+		for _, connection := range container.Connections {
+			if connection.Uuid != connectionUuid {
+				continue
+			} else if connection.Type != connectionType {
+				diagsResult.AddError(
+					fmt.Sprintf("Obtained BI integration [uuid: %s, connection_uuid: %s] but got unexpected connection "+
+						"type '%s'.", uuid, connection.Uuid, connection.Type),
+					"Users can manually fix remote state or delete this resource from the Terraform configuration.")
+				return nil, nil, diagsResult
+			}
+			return &container, &connection, diagsResult
+		}
+	}
+
+	toPrint := fmt.Sprintf("MC client 'GetBiContainers' query failed to find BI integration [uuid: %s, connection_uuid: %s]. "+
+		"This resource will be removed from the Terraform state without deletion.", uuid, connectionUuid)
+	diagsResult.AddWarning(toPrint, "")
+	return nil, nil, diagsResult
+}
+
+// readWarehouseConnection finds connection of the integration attached to the warehouse and returns the
+// timestamp of its last credentials update. If the warehouse or the connection is missing, or the warehouse
+// was moved to other Data Collector (checked only if collectorUuid is known), null is returned together
+// with a warning and the resource is expected to be removed from the Terraform state without deletion.
+func readWarehouseConnection(ctx context.Context, mcClient client.MonteCarloClient, warehouseUuid, collectorUuid, connectionUuid types.String,
+	connectionType string) (types.String, diag.Diagnostics) {
+	var diagsResult diag.Diagnostics
+	getResult := client.GetWarehouse{}
+	variables := map[string]interface{}{"uuid": client.UUID(warehouseUuid.ValueString())}
+
+	if bytes, err := mcClient.ExecRaw(ctx, client.GetWarehouseQuery, variables); err != nil && len(bytes) == 0 {
+		toPrint := fmt.Sprintf("MC client 'GetWarehouse' query result - %s", err.Error())
+		diagsResult.AddError(toPrint, "")
+		return types.StringNull(), diagsResult
+	} else if jsonErr := json.Unmarshal(bytes, &getResult); jsonErr != nil {
+		toPrint := fmt.Sprintf("MC client 'GetWarehouse' query failed to unmarshal data - %s", jsonErr.Error())
+		diagsResult.AddError(toPrint, "")
+		return types.StringNull(), diagsResult
+	} else if getResult.GetWarehouse == nil {
+		toPrint := fmt.Sprintf("MC client 'GetWarehouse' query failed to find warehouse [uuid: %s]. "+
+			"This resource will be removed from the Terraform state without deletion.", warehouseUuid.ValueString())
+		if err != nil {
+			toPrint = fmt.Sprintf("%s - %s", toPrint, err.Error())
+		} // response missing warehouse data may or may not contain error
+		diagsResult.AddWarning(toPrint, "")
+		return types.StringNull(), diagsResult
+	}
+
+	readCollectorUuid := getResult.GetWarehouse.DataCollector.Uuid
+	if !collectorUuid.IsNull() && readCollectorUuid != collectorUuid.ValueString() {
+		diagsResult.AddWarning(fmt.Sprintf("Obtained warehouse with [uuid: %s] but its Data "+
+			"Collector UUID does not match with configured value [obtained: %s, configured: %s]. Warehouse "+
+			"might have been moved to other Data Collector externally. This resource will be removed "+
+			"from the Terraform state without deletion.",
+			warehouseUuid.ValueString(), readCollectorUuid, collectorUuid.ValueString()), "")
+		return types.StringNull(), diagsResult
+	}
+
+	for _, connection := range getResult.GetWarehouse.Connections {
+		if connection.Uuid != connectionUuid.ValueString() {
+			continue
+		} else if connection.Type != connectionType {
+			diagsResult.AddError(
+				fmt.Sprintf("Obtained Warehouse [uuid: %s, connection_uuid: %s] but got unexpected connection "+
+					"type '%s'.", warehouseUuid.ValueString(), connection.Uuid, connection.Type),
+				"Users can manually fix remote state or delete this resource from the Terraform configuration.")
+			return types.StringNull(), diagsResult
+		} else if connection.UpdatedOn == "" {
+			return types.StringValue(connection.CreatedOn), diagsResult
+		}
+		return types.StringValue(connection.UpdatedOn), diagsResult
+	}
+
+	toPrint := fmt.Sprintf("MC client 'GetWarehouse' query failed to find connection [uuid: %s, connection_uuid: %s]. "+
+		"This resource will be removed from the Terraform state without deletion.",
+		warehouseUuid.ValueString(), connectionUuid.ValueString())
+	diagsResult.AddWarning(toPrint, "")
+	return types.StringNull(), diagsResult
+}
+
+func removeConnection(ctx context.Context, mcClient client.MonteCarloClient, deletionProtection types.Bool, connectionUuid types.String) diag.Diagnostics {
+	var diagsResult diag.Diagnostics
+	if deletionProtection.ValueBool() {
+		diagsResult.AddError(
+			"Failed to delete integration because deletion_protection is set to true. "+
+				"Set it to false to proceed with integration deletion",
+			"Deletion protection flag will prevent this resource deletion even if it was already deleted "+
+				"from the real system. For reasons why this is preferred behaviour check out documentation.",
+		)
+		return diagsResult
+	}
+
+	removeResult := client.RemoveConnection{}
+	variables := map[string]interface{}{"connectionId": client.UUID(connectionUuid.ValueString())}
+	if err := mcClient.Mutate(ctx, &removeResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'RemoveConnection' mutation result - %s", err.Error())
+		diagsResult.AddError(toPrint, "")
+	} else if !removeResult.RemoveConnection.Success {
+		toPrint := "MC client 'RemoveConnection' mutation - success = false, " +
+			"connection probably already doesn't exists. This resource will continue with its deletion"
+		diagsResult.AddWarning(toPrint, "")
+	}
+	return diagsResult
+}
+
+func importBiIntegration(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idsImported := strings.Split(req.ID, ",")
+	if len(idsImported) == 3 && idsImported[0] != "" && idsImported[1] != "" && idsImported[2] != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), idsImported[0])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("credentials").AtName("connection_uuid"), idsImported[1])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("collector_uuid"), idsImported[2])...)
+	} else {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf(
+			"Expected import identifier with format: <integration_uuid>,<connection_uuid>,<data_collector_uuid>. Got: %q", req.ID),
+		)
+	}
+}
+
+func importEtlIntegration(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idsImported := strings.Split(req.ID, ",")
+	if len(idsImported) == 3 && idsImported[0] != "" && idsImported[1] != "" && idsImported[2] != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("warehouse_uuid"), idsImported[0])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("credentials").AtName("connection_uuid"), idsImported[1])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("collector_uuid"), idsImported[2])...)
+	} else {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf(
+			"Expected import identifier with format: <warehouse_uuid>,<connection_uuid>,<data_collector_uuid>. Got: %q", req.ID),
+		)
+	}
+}
+
+// connectionUpdatedAt returns timestamp of the last credentials update, falling back to creation.
+func connectionUpdatedAt(connection *client.BiConnection) types.String {
+	if connection.UpdatedOn == "" {
+		return types.StringValue(connection.CreatedOn)
+	}
+	return types.StringValue(connection.UpdatedOn)
+}
@@ -0,0 +1,217 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DatabricksJobsIntegrationResource{}
+var _ resource.ResourceWithImportState = &DatabricksJobsIntegrationResource{}
+
+// To simplify provider implementations, a named function can be created with the resource implementation.
+func NewDatabricksJobsIntegrationResource() resource.Resource {
+	return &DatabricksJobsIntegrationResource{}
+}
+
+// DatabricksJobsIntegrationResource defines the resource implementation.
+type DatabricksJobsIntegrationResource struct {
+	client client.MonteCarloClient
+}
+
+// DatabricksJobsIntegrationResourceModel describes the resource data model according to its Schema.
+type DatabricksJobsIntegrationResourceModel struct {
+	WarehouseUuid      types.String              `tfsdk:"warehouse_uuid"`
+	CollectorUuid      types.String              `tfsdk:"collector_uuid"`
+	Credentials        DatabricksJobsCredentials `tfsdk:"credentials"`
+	DeletionProtection types.Bool                `tfsdk:"deletion_protection"`
+	CredentialsDrifted types.Bool                `tfsdk:"credentials_drifted"`
+}
+
+type DatabricksJobsCredentials struct {
+	ConnectionUuid types.String `tfsdk:"connection_uuid"`
+	WorkspaceUrl   types.String `tfsdk:"workspace_url"`
+	WorkspaceId    types.String `tfsdk:"workspace_id"`
+	Token          types.String `tfsdk:"token"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+}
+
+func (m DatabricksJobsIntegrationResourceModel) GetCollectorUuid() types.String {
+	return m.CollectorUuid
+}
+func (m DatabricksJobsIntegrationResourceModel) GetWarehouseUuid() types.String {
+	return m.WarehouseUuid
+}
+func (m DatabricksJobsIntegrationResourceModel) GetConnectionUuid() types.String {
+	return m.Credentials.ConnectionUuid
+}
+
+func (r *DatabricksJobsIntegrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_databricks_jobs_integration"
+}
+
+func (r *DatabricksJobsIntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"warehouse_uuid": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"collector_uuid": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"credentials": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"connection_uuid": schema.StringAttribute{
+						Computed: true,
+						Optional: false,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"workspace_url": schema.StringAttribute{
+						Required: true,
+					},
+					"workspace_id": schema.StringAttribute{
+						Required: true,
+					},
+					"token": schema.StringAttribute{
+						Required:  true,
+						Sensitive: true,
+					},
+					"updated_at": schema.StringAttribute{
+						Computed: true,
+						Optional: false,
+					},
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"credentials_drifted": schema.BoolAttribute{
+				Computed: true,
+				Optional: false,
+				PlanModifiers: []planmodifier.Bool{
+					common.RotateCredentialsIfDrifted(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DatabricksJobsIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *DatabricksJobsIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DatabricksJobsIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := addEtlConnection(ctx, r.client, r, data, client.DatabricksJobsConnectionType, []string{"databricks_jobs"}, DatabricksJobsKeyExtractor)
+	resp.Diagnostics.Append(diags...)
+	if result == nil {
+		return
+	}
+
+	data.Credentials.UpdatedAt = types.StringValue(result.AddConnection.Connection.CreatedOn)
+	data.Credentials.ConnectionUuid = types.StringValue(result.AddConnection.Connection.Uuid)
+	data.CredentialsDrifted = types.BoolValue(false)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabricksJobsIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DatabricksJobsIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readUpdatedAt, diags := readWarehouseConnection(ctx, r.client, data.WarehouseUuid, data.CollectorUuid,
+		data.Credentials.ConnectionUuid, client.DatabricksJobsConnectionTypeResponse)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	} else if readUpdatedAt.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.CredentialsDrifted = common.CredentialsDrifted(data.CredentialsDrifted, data.Credentials.UpdatedAt, readUpdatedAt)
+	data.Credentials.UpdatedAt = readUpdatedAt
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabricksJobsIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DatabricksJobsIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if updateResult, diags := updateConnection(ctx, r.client, r, data, DatabricksJobsKeyExtractor); updateResult == nil {
+		resp.Diagnostics.Append(diags...)
+	} else {
+		resp.Diagnostics.Append(diags...)
+		data.Credentials.UpdatedAt = types.StringValue(updateResult.UpdateCredentialsV2.UpdatedAt)
+		data.CredentialsDrifted = types.BoolValue(false)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+func (r *DatabricksJobsIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DatabricksJobsIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(removeConnection(ctx, r.client, data.DeletionProtection, data.Credentials.ConnectionUuid)...)
+}
+
+func (r *DatabricksJobsIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importEtlIntegration(ctx, req, resp)
+}
+
+func (r *DatabricksJobsIntegrationResource) testCredentials(ctx context.Context, data DatabricksJobsIntegrationResourceModel) (*client.TestDatabricksJobsCredentials, diag.Diagnostics) {
+	var diagsResult diag.Diagnostics
+	testResult := client.TestDatabricksJobsCredentials{}
+	variables := map[string]interface{}{
+		"workspaceUrl": data.Credentials.WorkspaceUrl.ValueString(),
+		"workspaceId":  data.Credentials.WorkspaceId.ValueString(),
+		"token":        data.Credentials.Token.ValueString(),
+	}
+
+	if err := r.client.Mutate(ctx, &testResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'TestDatabricksJobsCredentials' mutation result - %s", err.Error())
+		diagsResult.AddError(toPrint, "")
+		return nil, diagsResult
+	} else if !testResult.TestDatabricksJobsCredentials.Success {
+		diagsResult.AddAttributeError(credentialsPath, "MC client 'TestDatabricksJobsCredentials' mutation - success = false, "+
+			"Monte Carlo failed to connect to Databricks with provided credentials.", "")
+		return nil, diagsResult
+	}
+	return &testResult, diagsResult
+}
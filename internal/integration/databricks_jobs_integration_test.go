@@ -0,0 +1,80 @@
+package integration_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDatabricksJobsIntegrationResource(t *testing.T) {
+	t.Skip("Currently ignored due to dependency on live Databricks workspace")
+
+	mc_api_key_id := os.Getenv("MC_API_KEY_ID")
+	mc_api_key_token := os.Getenv("MC_API_KEY_TOKEN")
+	warehouseUuid := "da6c0716-2724-4bfc-b5cc-7e0364faf979"
+	collectorUuid := "9d1aee0a-6a90-47f0-8221-a884be707fc4"
+
+	databricksWorkspaceUrl := os.Getenv("DATABRICKS_WORKSPACE_URL")
+	databricksWorkspaceId := os.Getenv("DATABRICKS_WORKSPACE_ID")
+	databricksToken := os.Getenv("DATABRICKS_TOKEN")
+
+	if databricksWorkspaceUrl == "" {
+		t.Fatalf("'DATABRICKS_WORKSPACE_URL' must be set for this acceptance tests")
+	} else if databricksWorkspaceId == "" {
+		t.Fatalf("'DATABRICKS_WORKSPACE_ID' must be set for this acceptance tests")
+	} else if databricksToken == "" {
+		t.Fatalf("'DATABRICKS_TOKEN' must be set for this acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{ // Create and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("create.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+					"databricks_workspace_url": config.StringVariable(databricksWorkspaceUrl),
+					"databricks_workspace_id":  config.StringVariable(databricksWorkspaceId),
+					"databricks_token":         config.StringVariable(databricksToken),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_databricks_jobs_integration.test", "warehouse_uuid", warehouseUuid),
+					resource.TestCheckResourceAttr("montecarlo_databricks_jobs_integration.test", "collector_uuid", collectorUuid),
+					resource.TestCheckResourceAttr("montecarlo_databricks_jobs_integration.test", "deletion_protection", "false"),
+					resource.TestCheckResourceAttr("montecarlo_databricks_jobs_integration.test", "credentials_drifted", "false"),
+					resource.TestCheckResourceAttrSet("montecarlo_databricks_jobs_integration.test", "credentials.connection_uuid"),
+					resource.TestCheckResourceAttr("montecarlo_databricks_jobs_integration.test", "credentials.workspace_url", databricksWorkspaceUrl),
+					resource.TestCheckResourceAttr("montecarlo_databricks_jobs_integration.test", "credentials.workspace_id", databricksWorkspaceId),
+					resource.TestCheckResourceAttr("montecarlo_databricks_jobs_integration.test", "credentials.token", databricksToken),
+				),
+			},
+			{ // ImportState testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+					"databricks_workspace_url": config.StringVariable(databricksWorkspaceUrl),
+					"databricks_workspace_id":  config.StringVariable(databricksWorkspaceId),
+					"databricks_token":         config.StringVariable(databricksToken),
+				},
+				ResourceName:      "montecarlo_databricks_jobs_integration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					connectionUuid := s.RootModule().Resources["montecarlo_databricks_jobs_integration.test"].Primary.Attributes["credentials.connection_uuid"]
+					return fmt.Sprintf("%[1]s,%[2]s,%[3]s", warehouseUuid, connectionUuid, collectorUuid), nil
+				},
+				ImportStateVerifyIdentifierAttribute: "warehouse_uuid",
+				ImportStateVerifyIgnore:              []string{"deletion_protection", "credentials"},
+			},
+		},
+	})
+}
@@ -0,0 +1,296 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DbtCloudIntegrationResource{}
+var _ resource.ResourceWithImportState = &DbtCloudIntegrationResource{}
+
+// To simplify provider implementations, a named function can be created with the resource implementation.
+func NewDbtCloudIntegrationResource() resource.Resource {
+	return &DbtCloudIntegrationResource{}
+}
+
+// DbtCloudIntegrationResource defines the resource implementation.
+type DbtCloudIntegrationResource struct {
+	client client.MonteCarloClient
+}
+
+// DbtCloudIntegrationResourceModel describes the resource data model according to its Schema.
+type DbtCloudIntegrationResourceModel struct {
+	WarehouseUuid      types.String        `tfsdk:"warehouse_uuid"`
+	Credentials        DbtCloudCredentials `tfsdk:"credentials"`
+	WebhookEnabled     types.Bool          `tfsdk:"webhook_enabled"`
+	ProjectIds         []types.String      `tfsdk:"project_ids"`
+	JobIds             []types.String      `tfsdk:"job_ids"`
+	DeletionProtection types.Bool          `tfsdk:"deletion_protection"`
+	CredentialsDrifted types.Bool          `tfsdk:"credentials_drifted"`
+}
+
+type DbtCloudCredentials struct {
+	ConnectionUuid types.String `tfsdk:"connection_uuid"`
+	AccountId      types.String `tfsdk:"account_id"`
+	ServiceToken   types.String `tfsdk:"service_token"`
+	BaseUrl        types.String `tfsdk:"base_url"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+}
+
+// dbt Cloud connection is not bound to any Data Collector (connection is added only with the warehouse)
+func (m DbtCloudIntegrationResourceModel) GetCollectorUuid() types.String { return types.StringNull() }
+func (m DbtCloudIntegrationResourceModel) GetWarehouseUuid() types.String { return m.WarehouseUuid }
+func (m DbtCloudIntegrationResourceModel) GetConnectionUuid() types.String {
+	return m.Credentials.ConnectionUuid
+}
+
+func (r *DbtCloudIntegrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dbt_cloud_integration"
+}
+
+func (r *DbtCloudIntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"warehouse_uuid": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"credentials": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"connection_uuid": schema.StringAttribute{
+						Computed: true,
+						Optional: false,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"account_id": schema.StringAttribute{
+						Required: true,
+					},
+					"service_token": schema.StringAttribute{
+						Required:  true,
+						Sensitive: true,
+					},
+					"base_url": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString("https://cloud.getdbt.com"),
+					},
+					"updated_at": schema.StringAttribute{
+						Computed: true,
+						Optional: false,
+					},
+				},
+			},
+			"webhook_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"project_ids": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"job_ids": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"credentials_drifted": schema.BoolAttribute{
+				Computed: true,
+				Optional: false,
+				PlanModifiers: []planmodifier.Bool{
+					common.RotateCredentialsIfDrifted(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DbtCloudIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *DbtCloudIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DbtCloudIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addResult, diags := addEtlConnection(ctx, r.client, r, data, client.DbtCloudConnectionType, []string{"dbt_cloud"}, DbtCloudKeyExtractor)
+	resp.Diagnostics.Append(diags...)
+	if addResult == nil {
+		return
+	}
+
+	data.Credentials.UpdatedAt = types.StringValue(addResult.AddConnection.Connection.CreatedOn)
+	data.Credentials.ConnectionUuid = types.StringValue(addResult.AddConnection.Connection.Uuid)
+	data.CredentialsDrifted = types.BoolValue(false)
+	if settingsDiags := r.updateSettings(ctx, data); settingsDiags.HasError() {
+		// connection without the configured settings is not tracked, therefore it must not be left behind
+		resp.Diagnostics.Append(settingsDiags...)
+		resp.Diagnostics.Append(removeConnection(ctx, r.client, types.BoolValue(false), data.Credentials.ConnectionUuid)...)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DbtCloudIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DbtCloudIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readUpdatedAt, diags := readWarehouseConnection(ctx, r.client, data.WarehouseUuid, types.StringNull(),
+		data.Credentials.ConnectionUuid, client.DbtCloudConnectionTypeResponse)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	} else if readUpdatedAt.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	settingsResult := client.GetDbtCloudConnectionSettings{}
+	variables := map[string]interface{}{"connectionId": client.UUID(data.Credentials.ConnectionUuid.ValueString())}
+	if err := r.client.Query(ctx, &settingsResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'GetDbtCloudConnectionSettings' query result - %s", err.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+		return
+	}
+
+	settings := settingsResult.GetDbtCloudConnectionSettings
+	data.WebhookEnabled = types.BoolValue(settings.WebhooksEnabled)
+	data.ProjectIds = common.TfStringsFrom(settings.ProjectIds)
+	data.JobIds = common.TfStringsFrom(settings.JobIds)
+	data.CredentialsDrifted = common.CredentialsDrifted(data.CredentialsDrifted, data.Credentials.UpdatedAt, readUpdatedAt)
+	data.Credentials.UpdatedAt = readUpdatedAt
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DbtCloudIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DbtCloudIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// credentials are rotated only when changed, settings can be updated on their own
+	if state.CredentialsDrifted.ValueBool() || !data.Credentials.AccountId.Equal(state.Credentials.AccountId) ||
+		!data.Credentials.ServiceToken.Equal(state.Credentials.ServiceToken) || !data.Credentials.BaseUrl.Equal(state.Credentials.BaseUrl) {
+		updateResult, diags := updateConnection(ctx, r.client, r, data, DbtCloudKeyExtractor)
+		resp.Diagnostics.Append(diags...)
+		if updateResult == nil {
+			return
+		}
+		data.Credentials.UpdatedAt = types.StringValue(updateResult.UpdateCredentialsV2.UpdatedAt)
+	} else {
+		data.Credentials.UpdatedAt = state.Credentials.UpdatedAt
+	}
+
+	data.CredentialsDrifted = types.BoolValue(false)
+	if settingsDiags := r.updateSettings(ctx, data); settingsDiags.HasError() {
+		// rotated credentials are kept in the state (not to be detected as drift), settings remain to be applied
+		resp.Diagnostics.Append(settingsDiags...)
+		data.WebhookEnabled, data.ProjectIds, data.JobIds = state.WebhookEnabled, state.ProjectIds, state.JobIds
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DbtCloudIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DbtCloudIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(removeConnection(ctx, r.client, data.DeletionProtection, data.Credentials.ConnectionUuid)...)
+}
+
+func (r *DbtCloudIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idsImported := strings.Split(req.ID, ",")
+	if len(idsImported) == 2 && idsImported[0] != "" && idsImported[1] != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("warehouse_uuid"), idsImported[0])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("credentials").AtName("connection_uuid"), idsImported[1])...)
+	} else {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf(
+			"Expected import identifier with format: <warehouse_uuid>,<connection_uuid>. Got: %q", req.ID),
+		)
+	}
+}
+
+func (r *DbtCloudIntegrationResource) testCredentials(ctx context.Context, data DbtCloudIntegrationResourceModel) (*client.TestDbtCloudCredentials, diag.Diagnostics) {
+	var diagsResult diag.Diagnostics
+	testResult := client.TestDbtCloudCredentials{}
+	variables := map[string]interface{}{
+		"dbtCloudAccountId": data.Credentials.AccountId.ValueString(),
+		"dbtCloudApiToken":  data.Credentials.ServiceToken.ValueString(),
+		"dbtCloudBaseUrl":   data.Credentials.BaseUrl.ValueStringPointer(),
+	}
+
+	if err := r.client.Mutate(ctx, &testResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'TestDbtCloudCredentials' mutation result - %s", err.Error())
+		diagsResult.AddError(toPrint, "")
+		return nil, diagsResult
+	} else if !testResult.TestDbtCloudCredentials.Success {
+		diagsResult.AddAttributeError(credentialsPath, "MC client 'TestDbtCloudCredentials' mutation - success = false, "+
+			"Monte Carlo failed to connect to dbt Cloud with provided credentials.", "")
+		return nil, diagsResult
+	}
+	return &testResult, diagsResult
+}
+
+// updateSettings configures webhooks and filters of dbt Cloud projects and jobs. Empty filters
+// result in all projects and jobs of the dbt Cloud account being integrated.
+func (r *DbtCloudIntegrationResource) updateSettings(ctx context.Context, data DbtCloudIntegrationResourceModel) diag.Diagnostics {
+	var diagsResult diag.Diagnostics
+	updateResult := client.UpdateDbtCloudConnectionSettings{}
+	variables := map[string]interface{}{
+		"connectionId":    client.UUID(data.Credentials.ConnectionUuid.ValueString()),
+		"webhooksEnabled": data.WebhookEnabled.ValueBool(),
+		"projectIds":      common.TfStringsTo[string](data.ProjectIds),
+		"jobIds":          common.TfStringsTo[string](data.JobIds),
+	}
+
+	if err := r.client.Mutate(ctx, &updateResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'UpdateDbtCloudConnectionSettings' mutation result - %s", err.Error())
+		diagsResult.AddError(toPrint, "")
+	} else if !updateResult.UpdateDbtCloudConnectionSettings.Success {
+		toPrint := "MC client 'UpdateDbtCloudConnectionSettings' mutation - success = false, " +
+			"connection probably doesnt exists. Rerunning terraform operation usually helps."
+		diagsResult.AddError(toPrint, "")
+	}
+	return diagsResult
+}
@@ -0,0 +1,96 @@
+package integration_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDbtCloudIntegrationResource(t *testing.T) {
+	t.Skip("Currently ignored due to dependency on live dbt Cloud account")
+
+	mc_api_key_id := os.Getenv("MC_API_KEY_ID")
+	mc_api_key_token := os.Getenv("MC_API_KEY_TOKEN")
+	warehouseUuid := "da6c0716-2724-4bfc-b5cc-7e0364faf979"
+
+	dbtCloudAccountId := os.Getenv("DBT_CLOUD_ACCOUNT_ID")
+	dbtCloudServiceToken := os.Getenv("DBT_CLOUD_SERVICE_TOKEN")
+	dbtCloudJobId := os.Getenv("DBT_CLOUD_JOB_ID")
+
+	if dbtCloudAccountId == "" {
+		t.Fatalf("'DBT_CLOUD_ACCOUNT_ID' must be set for this acceptance tests")
+	} else if dbtCloudServiceToken == "" {
+		t.Fatalf("'DBT_CLOUD_SERVICE_TOKEN' must be set for this acceptance tests")
+	} else if dbtCloudJobId == "" {
+		t.Fatalf("'DBT_CLOUD_JOB_ID' must be set for this acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{ // Create and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("create.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+					"dbt_cloud_account_id":     config.StringVariable(dbtCloudAccountId),
+					"dbt_cloud_service_token":  config.StringVariable(dbtCloudServiceToken),
+					"dbt_cloud_job_id":         config.StringVariable(dbtCloudJobId),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_dbt_cloud_integration.test", "warehouse_uuid", warehouseUuid),
+					resource.TestCheckResourceAttr("montecarlo_dbt_cloud_integration.test", "webhook_enabled", "false"),
+					resource.TestCheckResourceAttr("montecarlo_dbt_cloud_integration.test", "job_ids.#", "0"),
+					resource.TestCheckResourceAttr("montecarlo_dbt_cloud_integration.test", "project_ids.#", "0"),
+					resource.TestCheckResourceAttr("montecarlo_dbt_cloud_integration.test", "credentials.account_id", dbtCloudAccountId),
+					resource.TestCheckResourceAttr("montecarlo_dbt_cloud_integration.test", "credentials.base_url", "https://cloud.getdbt.com"),
+					resource.TestCheckResourceAttr("montecarlo_dbt_cloud_integration.test", "credentials_drifted", "false"),
+					resource.TestCheckResourceAttrSet("montecarlo_dbt_cloud_integration.test", "credentials.connection_uuid"),
+				),
+			},
+			{ // ImportState testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+					"dbt_cloud_account_id":     config.StringVariable(dbtCloudAccountId),
+					"dbt_cloud_service_token":  config.StringVariable(dbtCloudServiceToken),
+					"dbt_cloud_job_id":         config.StringVariable(dbtCloudJobId),
+				},
+				ResourceName:      "montecarlo_dbt_cloud_integration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					connectionUuid := s.RootModule().Resources["montecarlo_dbt_cloud_integration.test"].Primary.Attributes["credentials.connection_uuid"]
+					return fmt.Sprintf("%[1]s,%[2]s", warehouseUuid, connectionUuid), nil
+				},
+				ImportStateVerifyIdentifierAttribute: "warehouse_uuid",
+				ImportStateVerifyIgnore: []string{"deletion_protection", "credentials", "webhook_enabled",
+					"project_ids", "job_ids"},
+			},
+			{ // Update and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("update.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+					"dbt_cloud_account_id":     config.StringVariable(dbtCloudAccountId),
+					"dbt_cloud_service_token":  config.StringVariable(dbtCloudServiceToken),
+					"dbt_cloud_job_id":         config.StringVariable(dbtCloudJobId),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_dbt_cloud_integration.test", "webhook_enabled", "true"),
+					resource.TestCheckResourceAttr("montecarlo_dbt_cloud_integration.test", "job_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("montecarlo_dbt_cloud_integration.test", "job_ids.*", dbtCloudJobId),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,174 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const dbtCoreProjectSource = "CLI"
+const dbtProjectsPageSize = 100
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DbtCoreProjectResource{}
+var _ resource.ResourceWithImportState = &DbtCoreProjectResource{}
+
+// To simplify provider implementations, a named function can be created with the resource implementation.
+func NewDbtCoreProjectResource() resource.Resource {
+	return &DbtCoreProjectResource{}
+}
+
+// DbtCoreProjectResource defines the resource implementation.
+type DbtCoreProjectResource struct {
+	client client.MonteCarloClient
+}
+
+// DbtCoreProjectResourceModel describes the resource data model according to its Schema.
+type DbtCoreProjectResourceModel struct {
+	Uuid          types.String `tfsdk:"uuid"`
+	ProjectName   types.String `tfsdk:"project_name"`
+	WarehouseUuid types.String `tfsdk:"warehouse_uuid"`
+}
+
+func (r *DbtCoreProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dbt_core_project"
+}
+
+func (r *DbtCoreProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Computed: true,
+				Optional: false,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_name": schema.StringAttribute{
+				Required:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"warehouse_uuid": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DbtCoreProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *DbtCoreProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DbtCoreProjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResult := client.CreateDbtProject{}
+	variables := map[string]interface{}{
+		"projectName": data.ProjectName.ValueString(),
+		"source":      dbtCoreProjectSource,
+		"dwId":        client.UUID(data.WarehouseUuid.ValueString()),
+	}
+
+	if err := r.client.Mutate(ctx, &createResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'CreateDbtProject' mutation result - %s", err.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+		return
+	}
+
+	data.Uuid = types.StringValue(createResult.CreateDbtProject.DbtProject.Uuid)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DbtCoreProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DbtCoreProjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variables := map[string]interface{}{
+		"first": dbtProjectsPageSize,
+		"after": (*string)(nil),
+	}
+
+	for hasNextPage := true; hasNextPage; {
+		getResult := client.GetDbtProjects{}
+		if err := r.client.Query(ctx, &getResult, variables); err != nil {
+			toPrint := fmt.Sprintf("MC client 'GetDbtProjects' query result - %s", err.Error())
+			resp.Diagnostics.AddError(toPrint, "")
+			return
+		}
+
+		for _, edge := range getResult.GetDbtProjects.Edges {
+			if edge.Node.Uuid == data.Uuid.ValueString() {
+				data.ProjectName = types.StringValue(edge.Node.ProjectName)
+				data.WarehouseUuid = types.StringValue(edge.Node.Warehouse.Uuid)
+				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				return
+			}
+		}
+
+		hasNextPage = getResult.GetDbtProjects.PageInfo.HasNextPage
+		variables["after"] = &getResult.GetDbtProjects.PageInfo.EndCursor
+	}
+
+	toPrint := fmt.Sprintf("MC client 'GetDbtProjects' query failed to find dbt project [uuid: %s]. "+
+		"This resource will be removed from the Terraform state without deletion.", data.Uuid.ValueString())
+	resp.Diagnostics.AddWarning(toPrint, "")
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *DbtCoreProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// all configurable attributes require replacement, there is nothing to update in-place
+	var data DbtCoreProjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+func (r *DbtCoreProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DbtCoreProjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteResult := client.DeleteDbtProject{}
+	variables := map[string]interface{}{"dbtProjectUuid": client.UUID(data.Uuid.ValueString())}
+	if err := r.client.Mutate(ctx, &deleteResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'DeleteDbtProject' mutation result - %s", err.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+	} else if !deleteResult.DeleteDbtProject.Success {
+		toPrint := "MC client 'DeleteDbtProject' mutation - success = false, " +
+			"dbt project probably already doesn't exists. This resource will continue with its deletion"
+		resp.Diagnostics.AddWarning(toPrint, "")
+	}
+}
+
+func (r *DbtCoreProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("uuid"), req, resp)
+}
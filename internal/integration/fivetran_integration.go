@@ -0,0 +1,217 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FivetranIntegrationResource{}
+var _ resource.ResourceWithImportState = &FivetranIntegrationResource{}
+
+// To simplify provider implementations, a named function can be created with the resource implementation.
+func NewFivetranIntegrationResource() resource.Resource {
+	return &FivetranIntegrationResource{}
+}
+
+// FivetranIntegrationResource defines the resource implementation.
+type FivetranIntegrationResource struct {
+	client client.MonteCarloClient
+}
+
+// FivetranIntegrationResourceModel describes the resource data model according to its Schema.
+type FivetranIntegrationResourceModel struct {
+	WarehouseUuid      types.String        `tfsdk:"warehouse_uuid"`
+	CollectorUuid      types.String        `tfsdk:"collector_uuid"`
+	Credentials        FivetranCredentials `tfsdk:"credentials"`
+	DeletionProtection types.Bool          `tfsdk:"deletion_protection"`
+	CredentialsDrifted types.Bool          `tfsdk:"credentials_drifted"`
+}
+
+type FivetranCredentials struct {
+	ConnectionUuid types.String `tfsdk:"connection_uuid"`
+	ApiKey         types.String `tfsdk:"api_key"`
+	ApiSecret      types.String `tfsdk:"api_secret"`
+	BaseUrl        types.String `tfsdk:"base_url"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+}
+
+func (m FivetranIntegrationResourceModel) GetCollectorUuid() types.String { return m.CollectorUuid }
+func (m FivetranIntegrationResourceModel) GetWarehouseUuid() types.String { return m.WarehouseUuid }
+func (m FivetranIntegrationResourceModel) GetConnectionUuid() types.String {
+	return m.Credentials.ConnectionUuid
+}
+
+func (r *FivetranIntegrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fivetran_integration"
+}
+
+func (r *FivetranIntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"warehouse_uuid": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"collector_uuid": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"credentials": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"connection_uuid": schema.StringAttribute{
+						Computed: true,
+						Optional: false,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"api_key": schema.StringAttribute{
+						Required:  true,
+						Sensitive: true,
+					},
+					"api_secret": schema.StringAttribute{
+						Required:  true,
+						Sensitive: true,
+					},
+					"base_url": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString("https://api.fivetran.com"),
+					},
+					"updated_at": schema.StringAttribute{
+						Computed: true,
+						Optional: false,
+					},
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"credentials_drifted": schema.BoolAttribute{
+				Computed: true,
+				Optional: false,
+				PlanModifiers: []planmodifier.Bool{
+					common.RotateCredentialsIfDrifted(),
+				},
+			},
+		},
+	}
+}
+
+func (r *FivetranIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *FivetranIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FivetranIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := addEtlConnection(ctx, r.client, r, data, client.FivetranConnectionType, []string{"fivetran"}, FivetranKeyExtractor)
+	resp.Diagnostics.Append(diags...)
+	if result == nil {
+		return
+	}
+
+	data.Credentials.UpdatedAt = types.StringValue(result.AddConnection.Connection.CreatedOn)
+	data.Credentials.ConnectionUuid = types.StringValue(result.AddConnection.Connection.Uuid)
+	data.CredentialsDrifted = types.BoolValue(false)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FivetranIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FivetranIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readUpdatedAt, diags := readWarehouseConnection(ctx, r.client, data.WarehouseUuid, data.CollectorUuid,
+		data.Credentials.ConnectionUuid, client.FivetranConnectionTypeResponse)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	} else if readUpdatedAt.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.CredentialsDrifted = common.CredentialsDrifted(data.CredentialsDrifted, data.Credentials.UpdatedAt, readUpdatedAt)
+	data.Credentials.UpdatedAt = readUpdatedAt
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FivetranIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FivetranIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if updateResult, diags := updateConnection(ctx, r.client, r, data, FivetranKeyExtractor); updateResult == nil {
+		resp.Diagnostics.Append(diags...)
+	} else {
+		resp.Diagnostics.Append(diags...)
+		data.Credentials.UpdatedAt = types.StringValue(updateResult.UpdateCredentialsV2.UpdatedAt)
+		data.CredentialsDrifted = types.BoolValue(false)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+func (r *FivetranIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FivetranIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(removeConnection(ctx, r.client, data.DeletionProtection, data.Credentials.ConnectionUuid)...)
+}
+
+func (r *FivetranIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importEtlIntegration(ctx, req, resp)
+}
+
+func (r *FivetranIntegrationResource) testCredentials(ctx context.Context, data FivetranIntegrationResourceModel) (*client.TestFivetranCredentials, diag.Diagnostics) {
+	var diagsResult diag.Diagnostics
+	testResult := client.TestFivetranCredentials{}
+	variables := map[string]interface{}{
+		"fivetranApiKey":      data.Credentials.ApiKey.ValueString(),
+		"fivetranApiPassword": data.Credentials.ApiSecret.ValueString(),
+		"fivetranBaseUrl":     data.Credentials.BaseUrl.ValueStringPointer(),
+	}
+
+	if err := r.client.Mutate(ctx, &testResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'TestFivetranCredentials' mutation result - %s", err.Error())
+		diagsResult.AddError(toPrint, "")
+		return nil, diagsResult
+	} else if !testResult.TestFivetranCredentials.Success {
+		diagsResult.AddAttributeError(credentialsPath, "MC client 'TestFivetranCredentials' mutation - success = false, "+
+			"Monte Carlo failed to connect to Fivetran with provided credentials.", "")
+		return nil, diagsResult
+	}
+	return &testResult, diagsResult
+}
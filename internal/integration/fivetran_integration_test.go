@@ -0,0 +1,75 @@
+package integration_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccFivetranIntegrationResource(t *testing.T) {
+	t.Skip("Currently ignored due to dependency on live Fivetran account")
+
+	mc_api_key_id := os.Getenv("MC_API_KEY_ID")
+	mc_api_key_token := os.Getenv("MC_API_KEY_TOKEN")
+	warehouseUuid := "da6c0716-2724-4bfc-b5cc-7e0364faf979"
+	collectorUuid := "9d1aee0a-6a90-47f0-8221-a884be707fc4"
+
+	fivetranApiKey := os.Getenv("FIVETRAN_API_KEY")
+	fivetranApiSecret := os.Getenv("FIVETRAN_API_SECRET")
+
+	if fivetranApiKey == "" {
+		t.Fatalf("'FIVETRAN_API_KEY' must be set for this acceptance tests")
+	} else if fivetranApiSecret == "" {
+		t.Fatalf("'FIVETRAN_API_SECRET' must be set for this acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{ // Create and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("create.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+					"fivetran_api_key":         config.StringVariable(fivetranApiKey),
+					"fivetran_api_secret":      config.StringVariable(fivetranApiSecret),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_fivetran_integration.test", "warehouse_uuid", warehouseUuid),
+					resource.TestCheckResourceAttr("montecarlo_fivetran_integration.test", "collector_uuid", collectorUuid),
+					resource.TestCheckResourceAttr("montecarlo_fivetran_integration.test", "deletion_protection", "false"),
+					resource.TestCheckResourceAttr("montecarlo_fivetran_integration.test", "credentials_drifted", "false"),
+					resource.TestCheckResourceAttrSet("montecarlo_fivetran_integration.test", "credentials.connection_uuid"),
+					resource.TestCheckResourceAttr("montecarlo_fivetran_integration.test", "credentials.api_key", fivetranApiKey),
+					resource.TestCheckResourceAttr("montecarlo_fivetran_integration.test", "credentials.api_secret", fivetranApiSecret),
+					resource.TestCheckResourceAttr("montecarlo_fivetran_integration.test", "credentials.base_url", "https://api.fivetran.com"),
+				),
+			},
+			{ // ImportState testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+					"fivetran_api_key":         config.StringVariable(fivetranApiKey),
+					"fivetran_api_secret":      config.StringVariable(fivetranApiSecret),
+				},
+				ResourceName:      "montecarlo_fivetran_integration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					connectionUuid := s.RootModule().Resources["montecarlo_fivetran_integration.test"].Primary.Attributes["credentials.connection_uuid"]
+					return fmt.Sprintf("%[1]s,%[2]s,%[3]s", warehouseUuid, connectionUuid, collectorUuid), nil
+				},
+				ImportStateVerifyIdentifierAttribute: "warehouse_uuid",
+				ImportStateVerifyIgnore:              []string{"deletion_protection", "credentials"},
+			},
+		},
+	})
+}
@@ -0,0 +1,235 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LookerIntegrationResource{}
+var _ resource.ResourceWithImportState = &LookerIntegrationResource{}
+
+// To simplify provider implementations, a named function can be created with the resource implementation.
+func NewLookerIntegrationResource() resource.Resource {
+	return &LookerIntegrationResource{}
+}
+
+// LookerIntegrationResource defines the resource implementation.
+type LookerIntegrationResource struct {
+	client client.MonteCarloClient
+}
+
+// LookerIntegrationResourceModel describes the resource data model according to its Schema.
+type LookerIntegrationResourceModel struct {
+	Uuid               types.String      `tfsdk:"uuid"`
+	Name               types.String      `tfsdk:"name"`
+	CollectorUuid      types.String      `tfsdk:"collector_uuid"`
+	Credentials        LookerCredentials `tfsdk:"credentials"`
+	DeletionProtection types.Bool        `tfsdk:"deletion_protection"`
+	CredentialsDrifted types.Bool        `tfsdk:"credentials_drifted"`
+}
+
+type LookerCredentials struct {
+	ConnectionUuid types.String `tfsdk:"connection_uuid"`
+	BaseUrl        types.String `tfsdk:"base_url"`
+	ClientId       types.String `tfsdk:"client_id"`
+	ClientSecret   types.String `tfsdk:"client_secret"`
+	VerifySsl      types.Bool   `tfsdk:"verify_ssl"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+}
+
+func (m LookerIntegrationResourceModel) GetCollectorUuid() types.String { return m.CollectorUuid }
+func (m LookerIntegrationResourceModel) GetName() types.String          { return m.Name }
+func (m LookerIntegrationResourceModel) GetConnectionUuid() types.String {
+	return m.Credentials.ConnectionUuid
+}
+
+func (r *LookerIntegrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_looker_integration"
+}
+
+func (r *LookerIntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Computed: true,
+				Optional: false,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"collector_uuid": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"credentials": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"connection_uuid": schema.StringAttribute{
+						Computed: true,
+						Optional: false,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"base_url": schema.StringAttribute{
+						Required: true,
+					},
+					"client_id": schema.StringAttribute{
+						Required:  true,
+						Sensitive: true,
+					},
+					"client_secret": schema.StringAttribute{
+						Required:  true,
+						Sensitive: true,
+					},
+					"verify_ssl": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(true),
+					},
+					"updated_at": schema.StringAttribute{
+						Computed: true,
+						Optional: false,
+					},
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"credentials_drifted": schema.BoolAttribute{
+				Computed: true,
+				Optional: false,
+				PlanModifiers: []planmodifier.Bool{
+					common.RotateCredentialsIfDrifted(),
+				},
+			},
+		},
+	}
+}
+
+func (r *LookerIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *LookerIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data LookerIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := addBiConnection(ctx, r.client, r, data, client.LookerConnectionType, LookerKeyExtractor)
+	resp.Diagnostics.Append(diags...)
+	if result == nil {
+		return
+	}
+
+	data.Uuid = types.StringValue(result.AddBiConnection.Connection.BiContainer.Uuid)
+	data.Credentials.UpdatedAt = types.StringValue(result.AddBiConnection.Connection.CreatedOn)
+	data.Credentials.ConnectionUuid = types.StringValue(result.AddBiConnection.Connection.Uuid)
+	data.CredentialsDrifted = types.BoolValue(false)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LookerIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data LookerIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	container, connection, diags := readBiConnection(ctx, r.client, data.Uuid.ValueString(), data.CollectorUuid.ValueString(),
+		data.Credentials.ConnectionUuid.ValueString(), client.LookerConnectionTypeResponse)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	} else if container == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	readUpdatedAt := connectionUpdatedAt(connection)
+	data.CredentialsDrifted = common.CredentialsDrifted(data.CredentialsDrifted, data.Credentials.UpdatedAt, readUpdatedAt)
+	data.Credentials.UpdatedAt = readUpdatedAt
+	data.Name = types.StringValue(container.Name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LookerIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data LookerIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if updateResult, diags := updateConnection(ctx, r.client, r, data, LookerKeyExtractor); updateResult == nil {
+		resp.Diagnostics.Append(diags...)
+	} else {
+		resp.Diagnostics.Append(diags...)
+		data.Credentials.UpdatedAt = types.StringValue(updateResult.UpdateCredentialsV2.UpdatedAt)
+		data.CredentialsDrifted = types.BoolValue(false)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+func (r *LookerIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data LookerIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(removeConnection(ctx, r.client, data.DeletionProtection, data.Credentials.ConnectionUuid)...)
+}
+
+func (r *LookerIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importBiIntegration(ctx, req, resp)
+}
+
+func (r *LookerIntegrationResource) testCredentials(ctx context.Context, data LookerIntegrationResourceModel) (*client.TestLookerCredentials, diag.Diagnostics) {
+	var diagsResult diag.Diagnostics
+	testResult := client.TestLookerCredentials{}
+	variables := map[string]interface{}{
+		"baseUrl":      data.Credentials.BaseUrl.ValueString(),
+		"clientId":     data.Credentials.ClientId.ValueString(),
+		"clientSecret": data.Credentials.ClientSecret.ValueString(),
+		"verifySsl":    data.Credentials.VerifySsl.ValueBoolPointer(),
+	}
+
+	if err := r.client.Mutate(ctx, &testResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'TestLookerCredentials' mutation result - %s", err.Error())
+		diagsResult.AddError(toPrint, "")
+		return nil, diagsResult
+	} else if !testResult.TestLookerCredentials.Success {
+		diagsResult.AddAttributeError(credentialsPath, "MC client 'TestLookerCredentials' mutation - success = false, "+
+			"Monte Carlo failed to connect to Looker with provided credentials.", "")
+		return nil, diagsResult
+	}
+	return &testResult, diagsResult
+}
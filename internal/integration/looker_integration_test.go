@@ -0,0 +1,81 @@
+package integration_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccLookerIntegrationResource(t *testing.T) {
+	t.Skip("Currently ignored due to dependency on live Looker instance")
+
+	mc_api_key_id := os.Getenv("MC_API_KEY_ID")
+	mc_api_key_token := os.Getenv("MC_API_KEY_TOKEN")
+	collectorUuid := "9d1aee0a-6a90-47f0-8221-a884be707fc4"
+
+	lookerBaseUrl := os.Getenv("LOOKER_BASE_URL")
+	lookerClientId := os.Getenv("LOOKER_CLIENT_ID")
+	lookerClientSecret := os.Getenv("LOOKER_CLIENT_SECRET")
+
+	if lookerBaseUrl == "" {
+		t.Fatalf("'LOOKER_BASE_URL' must be set for this acceptance tests")
+	} else if lookerClientId == "" {
+		t.Fatalf("'LOOKER_CLIENT_ID' must be set for this acceptance tests")
+	} else if lookerClientSecret == "" {
+		t.Fatalf("'LOOKER_CLIENT_SECRET' must be set for this acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{ // Create and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("create.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+					"looker_base_url":          config.StringVariable(lookerBaseUrl),
+					"looker_client_id":         config.StringVariable(lookerClientId),
+					"looker_client_secret":     config.StringVariable(lookerClientSecret),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_looker_integration.test", "name", "test-looker"),
+					resource.TestCheckResourceAttr("montecarlo_looker_integration.test", "collector_uuid", collectorUuid),
+					resource.TestCheckResourceAttr("montecarlo_looker_integration.test", "deletion_protection", "false"),
+					resource.TestCheckResourceAttr("montecarlo_looker_integration.test", "credentials_drifted", "false"),
+					resource.TestCheckResourceAttrSet("montecarlo_looker_integration.test", "uuid"),
+					resource.TestCheckResourceAttrSet("montecarlo_looker_integration.test", "credentials.connection_uuid"),
+					resource.TestCheckResourceAttr("montecarlo_looker_integration.test", "credentials.base_url", lookerBaseUrl),
+					resource.TestCheckResourceAttr("montecarlo_looker_integration.test", "credentials.client_id", lookerClientId),
+					resource.TestCheckResourceAttr("montecarlo_looker_integration.test", "credentials.client_secret", lookerClientSecret),
+				),
+			},
+			{ // ImportState testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+					"looker_base_url":          config.StringVariable(lookerBaseUrl),
+					"looker_client_id":         config.StringVariable(lookerClientId),
+					"looker_client_secret":     config.StringVariable(lookerClientSecret),
+				},
+				ResourceName:      "montecarlo_looker_integration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					uuid := s.RootModule().Resources["montecarlo_looker_integration.test"].Primary.Attributes["uuid"]
+					connectionUuid := s.RootModule().Resources["montecarlo_looker_integration.test"].Primary.Attributes["credentials.connection_uuid"]
+					return fmt.Sprintf("%[1]s,%[2]s,%[3]s", uuid, connectionUuid, collectorUuid), nil
+				},
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ImportStateVerifyIgnore:              []string{"deletion_protection", "credentials"},
+			},
+		},
+	})
+}
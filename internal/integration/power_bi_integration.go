@@ -0,0 +1,261 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PowerBiIntegrationResource{}
+var _ resource.ResourceWithImportState = &PowerBiIntegrationResource{}
+var _ resource.ResourceWithConfigValidators = &PowerBiIntegrationResource{}
+
+// To simplify provider implementations, a named function can be created with the resource implementation.
+func NewPowerBiIntegrationResource() resource.Resource {
+	return &PowerBiIntegrationResource{}
+}
+
+// PowerBiIntegrationResource defines the resource implementation.
+type PowerBiIntegrationResource struct {
+	client client.MonteCarloClient
+}
+
+// PowerBiIntegrationResourceModel describes the resource data model according to its Schema.
+type PowerBiIntegrationResourceModel struct {
+	Uuid               types.String       `tfsdk:"uuid"`
+	Name               types.String       `tfsdk:"name"`
+	CollectorUuid      types.String       `tfsdk:"collector_uuid"`
+	Credentials        PowerBiCredentials `tfsdk:"credentials"`
+	DeletionProtection types.Bool         `tfsdk:"deletion_protection"`
+	CredentialsDrifted types.Bool         `tfsdk:"credentials_drifted"`
+}
+
+type PowerBiCredentials struct {
+	ConnectionUuid types.String `tfsdk:"connection_uuid"`
+	TenantId       types.String `tfsdk:"tenant_id"`
+	ClientId       types.String `tfsdk:"client_id"`
+	ClientSecret   types.String `tfsdk:"client_secret"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+}
+
+func (m PowerBiIntegrationResourceModel) GetCollectorUuid() types.String { return m.CollectorUuid }
+func (m PowerBiIntegrationResourceModel) GetName() types.String          { return m.Name }
+func (m PowerBiIntegrationResourceModel) GetConnectionUuid() types.String {
+	return m.Credentials.ConnectionUuid
+}
+
+func (r *PowerBiIntegrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_power_bi_integration"
+}
+
+func (r *PowerBiIntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Computed: true,
+				Optional: false,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"collector_uuid": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"credentials": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"connection_uuid": schema.StringAttribute{
+						Computed: true,
+						Optional: false,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"tenant_id": schema.StringAttribute{
+						Required: true,
+					},
+					"client_id": schema.StringAttribute{
+						Required:  true,
+						Sensitive: true,
+					},
+					"client_secret": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+					"username": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+					"password": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+					"updated_at": schema.StringAttribute{
+						Computed: true,
+						Optional: false,
+					},
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"credentials_drifted": schema.BoolAttribute{
+				Computed: true,
+				Optional: false,
+				PlanModifiers: []planmodifier.Bool{
+					common.RotateCredentialsIfDrifted(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PowerBiIntegrationResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	credentials := path.MatchRoot("credentials")
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(credentials.AtName("client_secret"), credentials.AtName("username")),
+		resourcevalidator.RequiredTogether(credentials.AtName("username"), credentials.AtName("password")),
+	}
+}
+
+func (r *PowerBiIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *PowerBiIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PowerBiIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := addBiConnection(ctx, r.client, r, data, client.PowerBiConnectionType, PowerBiKeyExtractor)
+	resp.Diagnostics.Append(diags...)
+	if result == nil {
+		return
+	}
+
+	data.Uuid = types.StringValue(result.AddBiConnection.Connection.BiContainer.Uuid)
+	data.Credentials.UpdatedAt = types.StringValue(result.AddBiConnection.Connection.CreatedOn)
+	data.Credentials.ConnectionUuid = types.StringValue(result.AddBiConnection.Connection.Uuid)
+	data.CredentialsDrifted = types.BoolValue(false)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PowerBiIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PowerBiIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	container, connection, diags := readBiConnection(ctx, r.client, data.Uuid.ValueString(), data.CollectorUuid.ValueString(),
+		data.Credentials.ConnectionUuid.ValueString(), client.PowerBiConnectionTypeResponse)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	} else if container == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	readUpdatedAt := connectionUpdatedAt(connection)
+	data.CredentialsDrifted = common.CredentialsDrifted(data.CredentialsDrifted, data.Credentials.UpdatedAt, readUpdatedAt)
+	data.Credentials.UpdatedAt = readUpdatedAt
+	data.Name = types.StringValue(container.Name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PowerBiIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PowerBiIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if updateResult, diags := updateConnection(ctx, r.client, r, data, PowerBiKeyExtractor); updateResult == nil {
+		resp.Diagnostics.Append(diags...)
+	} else {
+		resp.Diagnostics.Append(diags...)
+		data.Credentials.UpdatedAt = types.StringValue(updateResult.UpdateCredentialsV2.UpdatedAt)
+		data.CredentialsDrifted = types.BoolValue(false)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+func (r *PowerBiIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PowerBiIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(removeConnection(ctx, r.client, data.DeletionProtection, data.Credentials.ConnectionUuid)...)
+}
+
+func (r *PowerBiIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importBiIntegration(ctx, req, resp)
+}
+
+func (r *PowerBiIntegrationResource) testCredentials(ctx context.Context, data PowerBiIntegrationResourceModel) (*client.TestPowerBiCredentials, diag.Diagnostics) {
+	var diagsResult diag.Diagnostics
+	testResult := client.TestPowerBiCredentials{}
+	variables := map[string]interface{}{
+		"authMode":     data.Credentials.authMode(),
+		"tenantId":     data.Credentials.TenantId.ValueString(),
+		"clientId":     data.Credentials.ClientId.ValueString(),
+		"clientSecret": data.Credentials.ClientSecret.ValueStringPointer(),
+		"username":     data.Credentials.Username.ValueStringPointer(),
+		"password":     data.Credentials.Password.ValueStringPointer(),
+	}
+
+	if err := r.client.Mutate(ctx, &testResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'TestPowerBiCredentials' mutation result - %s", err.Error())
+		diagsResult.AddError(toPrint, "")
+		return nil, diagsResult
+	} else if !testResult.TestPowerBiCredentials.Success {
+		diagsResult.AddAttributeError(credentialsPath, "MC client 'TestPowerBiCredentials' mutation - success = false, "+
+			"Monte Carlo failed to connect to Power BI with provided credentials.", "")
+		return nil, diagsResult
+	}
+	return &testResult, diagsResult
+}
+
+// authMode of the Power BI connection is given by configured credentials, service principal
+// authenticates using client secret while primary user authenticates using username and password.
+func (c PowerBiCredentials) authMode() client.PowerBIAuthModeEnum {
+	if c.ClientSecret.IsNull() {
+		return client.PowerBIAuthModeEnum("PRIMARY_USER")
+	}
+	return client.PowerBIAuthModeEnum("SERVICE_PRINCIPAL")
+}
@@ -0,0 +1,81 @@
+package integration_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccPowerBiIntegrationResource(t *testing.T) {
+	t.Skip("Currently ignored due to dependency on live Power BI tenant")
+
+	mc_api_key_id := os.Getenv("MC_API_KEY_ID")
+	mc_api_key_token := os.Getenv("MC_API_KEY_TOKEN")
+	collectorUuid := "9d1aee0a-6a90-47f0-8221-a884be707fc4"
+
+	powerBiTenantId := os.Getenv("POWER_BI_TENANT_ID")
+	powerBiClientId := os.Getenv("POWER_BI_CLIENT_ID")
+	powerBiClientSecret := os.Getenv("POWER_BI_CLIENT_SECRET")
+
+	if powerBiTenantId == "" {
+		t.Fatalf("'POWER_BI_TENANT_ID' must be set for this acceptance tests")
+	} else if powerBiClientId == "" {
+		t.Fatalf("'POWER_BI_CLIENT_ID' must be set for this acceptance tests")
+	} else if powerBiClientSecret == "" {
+		t.Fatalf("'POWER_BI_CLIENT_SECRET' must be set for this acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{ // Create and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("create.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+					"power_bi_tenant_id":       config.StringVariable(powerBiTenantId),
+					"power_bi_client_id":       config.StringVariable(powerBiClientId),
+					"power_bi_client_secret":   config.StringVariable(powerBiClientSecret),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_power_bi_integration.test", "name", "test-power-bi"),
+					resource.TestCheckResourceAttr("montecarlo_power_bi_integration.test", "collector_uuid", collectorUuid),
+					resource.TestCheckResourceAttr("montecarlo_power_bi_integration.test", "deletion_protection", "false"),
+					resource.TestCheckResourceAttr("montecarlo_power_bi_integration.test", "credentials_drifted", "false"),
+					resource.TestCheckResourceAttrSet("montecarlo_power_bi_integration.test", "uuid"),
+					resource.TestCheckResourceAttrSet("montecarlo_power_bi_integration.test", "credentials.connection_uuid"),
+					resource.TestCheckResourceAttr("montecarlo_power_bi_integration.test", "credentials.tenant_id", powerBiTenantId),
+					resource.TestCheckResourceAttr("montecarlo_power_bi_integration.test", "credentials.client_id", powerBiClientId),
+					resource.TestCheckResourceAttr("montecarlo_power_bi_integration.test", "credentials.client_secret", powerBiClientSecret),
+				),
+			},
+			{ // ImportState testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+					"power_bi_tenant_id":       config.StringVariable(powerBiTenantId),
+					"power_bi_client_id":       config.StringVariable(powerBiClientId),
+					"power_bi_client_secret":   config.StringVariable(powerBiClientSecret),
+				},
+				ResourceName:      "montecarlo_power_bi_integration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					uuid := s.RootModule().Resources["montecarlo_power_bi_integration.test"].Primary.Attributes["uuid"]
+					connectionUuid := s.RootModule().Resources["montecarlo_power_bi_integration.test"].Primary.Attributes["credentials.connection_uuid"]
+					return fmt.Sprintf("%[1]s,%[2]s,%[3]s", uuid, connectionUuid, collectorUuid), nil
+				},
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ImportStateVerifyIgnore:              []string{"deletion_protection", "credentials"},
+			},
+		},
+	})
+}
@@ -0,0 +1,267 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TableauIntegrationResource{}
+var _ resource.ResourceWithImportState = &TableauIntegrationResource{}
+var _ resource.ResourceWithConfigValidators = &TableauIntegrationResource{}
+
+// To simplify provider implementations, a named function can be created with the resource implementation.
+func NewTableauIntegrationResource() resource.Resource {
+	return &TableauIntegrationResource{}
+}
+
+// TableauIntegrationResource defines the resource implementation.
+type TableauIntegrationResource struct {
+	client client.MonteCarloClient
+}
+
+// TableauIntegrationResourceModel describes the resource data model according to its Schema.
+type TableauIntegrationResourceModel struct {
+	Uuid               types.String       `tfsdk:"uuid"`
+	Name               types.String       `tfsdk:"name"`
+	CollectorUuid      types.String       `tfsdk:"collector_uuid"`
+	Credentials        TableauCredentials `tfsdk:"credentials"`
+	DeletionProtection types.Bool         `tfsdk:"deletion_protection"`
+	CredentialsDrifted types.Bool         `tfsdk:"credentials_drifted"`
+}
+
+type TableauCredentials struct {
+	ConnectionUuid types.String `tfsdk:"connection_uuid"`
+	ServerName     types.String `tfsdk:"server_name"`
+	SiteName       types.String `tfsdk:"site_name"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+	TokenName      types.String `tfsdk:"token_name"`
+	TokenValue     types.String `tfsdk:"token_value"`
+	VerifySsl      types.Bool   `tfsdk:"verify_ssl"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+}
+
+func (m TableauIntegrationResourceModel) GetCollectorUuid() types.String { return m.CollectorUuid }
+func (m TableauIntegrationResourceModel) GetName() types.String          { return m.Name }
+func (m TableauIntegrationResourceModel) GetConnectionUuid() types.String {
+	return m.Credentials.ConnectionUuid
+}
+
+func (r *TableauIntegrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tableau_integration"
+}
+
+func (r *TableauIntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Computed: true,
+				Optional: false,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"collector_uuid": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"credentials": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"connection_uuid": schema.StringAttribute{
+						Computed: true,
+						Optional: false,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"server_name": schema.StringAttribute{
+						Required: true,
+					},
+					"site_name": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString(""),
+					},
+					"username": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+					"password": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+					"token_name": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+					"token_value": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+					"verify_ssl": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(true),
+					},
+					"updated_at": schema.StringAttribute{
+						Computed: true,
+						Optional: false,
+					},
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"credentials_drifted": schema.BoolAttribute{
+				Computed: true,
+				Optional: false,
+				PlanModifiers: []planmodifier.Bool{
+					common.RotateCredentialsIfDrifted(),
+				},
+			},
+		},
+	}
+}
+
+func (r *TableauIntegrationResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	credentials := path.MatchRoot("credentials")
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(credentials.AtName("username"), credentials.AtName("token_name")),
+		resourcevalidator.RequiredTogether(credentials.AtName("username"), credentials.AtName("password")),
+		resourcevalidator.RequiredTogether(credentials.AtName("token_name"), credentials.AtName("token_value")),
+	}
+}
+
+func (r *TableauIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *TableauIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TableauIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := addBiConnection(ctx, r.client, r, data, client.TableauConnectionType, TableauKeyExtractor)
+	resp.Diagnostics.Append(diags...)
+	if result == nil {
+		return
+	}
+
+	data.Uuid = types.StringValue(result.AddBiConnection.Connection.BiContainer.Uuid)
+	data.Credentials.UpdatedAt = types.StringValue(result.AddBiConnection.Connection.CreatedOn)
+	data.Credentials.ConnectionUuid = types.StringValue(result.AddBiConnection.Connection.Uuid)
+	data.CredentialsDrifted = types.BoolValue(false)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TableauIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TableauIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	container, connection, diags := readBiConnection(ctx, r.client, data.Uuid.ValueString(), data.CollectorUuid.ValueString(),
+		data.Credentials.ConnectionUuid.ValueString(), client.TableauConnectionTypeResponse)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	} else if container == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	readUpdatedAt := connectionUpdatedAt(connection)
+	data.CredentialsDrifted = common.CredentialsDrifted(data.CredentialsDrifted, data.Credentials.UpdatedAt, readUpdatedAt)
+	data.Credentials.UpdatedAt = readUpdatedAt
+	data.Name = types.StringValue(container.Name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TableauIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TableauIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if updateResult, diags := updateConnection(ctx, r.client, r, data, TableauKeyExtractor); updateResult == nil {
+		resp.Diagnostics.Append(diags...)
+	} else {
+		resp.Diagnostics.Append(diags...)
+		data.Credentials.UpdatedAt = types.StringValue(updateResult.UpdateCredentialsV2.UpdatedAt)
+		data.CredentialsDrifted = types.BoolValue(false)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+func (r *TableauIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TableauIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(removeConnection(ctx, r.client, data.DeletionProtection, data.Credentials.ConnectionUuid)...)
+}
+
+func (r *TableauIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importBiIntegration(ctx, req, resp)
+}
+
+func (r *TableauIntegrationResource) testCredentials(ctx context.Context, data TableauIntegrationResourceModel) (*client.TestTableauCredentials, diag.Diagnostics) {
+	var diagsResult diag.Diagnostics
+	testResult := client.TestTableauCredentials{}
+	variables := map[string]interface{}{
+		"serverName": data.Credentials.ServerName.ValueString(),
+		"siteName":   data.Credentials.SiteName.ValueStringPointer(),
+		"username":   data.Credentials.Username.ValueStringPointer(),
+		"password":   data.Credentials.Password.ValueStringPointer(),
+		"tokenName":  data.Credentials.TokenName.ValueStringPointer(),
+		"tokenValue": data.Credentials.TokenValue.ValueStringPointer(),
+		"verifySsl":  data.Credentials.VerifySsl.ValueBoolPointer(),
+	}
+
+	if err := r.client.Mutate(ctx, &testResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'TestTableauCredentials' mutation result - %s", err.Error())
+		diagsResult.AddError(toPrint, "")
+		return nil, diagsResult
+	} else if !testResult.TestTableauCredentials.Success {
+		diagsResult.AddAttributeError(credentialsPath, "MC client 'TestTableauCredentials' mutation - success = false, "+
+			"Monte Carlo failed to connect to Tableau with provided credentials.", "")
+		return nil, diagsResult
+	}
+	return &testResult, diagsResult
+}
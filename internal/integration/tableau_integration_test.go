@@ -0,0 +1,87 @@
+package integration_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccTableauIntegrationResource(t *testing.T) {
+	t.Skip("Currently ignored due to dependency on live Tableau instance")
+
+	mc_api_key_id := os.Getenv("MC_API_KEY_ID")
+	mc_api_key_token := os.Getenv("MC_API_KEY_TOKEN")
+	collectorUuid := "9d1aee0a-6a90-47f0-8221-a884be707fc4"
+
+	tableauServerName := os.Getenv("TABLEAU_SERVER_NAME")
+	tableauSiteName := os.Getenv("TABLEAU_SITE_NAME")
+	tableauTokenName := os.Getenv("TABLEAU_TOKEN_NAME")
+	tableauTokenValue := os.Getenv("TABLEAU_TOKEN_VALUE")
+
+	if tableauServerName == "" {
+		t.Fatalf("'TABLEAU_SERVER_NAME' must be set for this acceptance tests")
+	} else if tableauSiteName == "" {
+		t.Fatalf("'TABLEAU_SITE_NAME' must be set for this acceptance tests")
+	} else if tableauTokenName == "" {
+		t.Fatalf("'TABLEAU_TOKEN_NAME' must be set for this acceptance tests")
+	} else if tableauTokenValue == "" {
+		t.Fatalf("'TABLEAU_TOKEN_VALUE' must be set for this acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{ // Create and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("create.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+					"tableau_server_name":      config.StringVariable(tableauServerName),
+					"tableau_site_name":        config.StringVariable(tableauSiteName),
+					"tableau_token_name":       config.StringVariable(tableauTokenName),
+					"tableau_token_value":      config.StringVariable(tableauTokenValue),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_tableau_integration.test", "name", "test-tableau"),
+					resource.TestCheckResourceAttr("montecarlo_tableau_integration.test", "collector_uuid", collectorUuid),
+					resource.TestCheckResourceAttr("montecarlo_tableau_integration.test", "deletion_protection", "false"),
+					resource.TestCheckResourceAttr("montecarlo_tableau_integration.test", "credentials_drifted", "false"),
+					resource.TestCheckResourceAttrSet("montecarlo_tableau_integration.test", "uuid"),
+					resource.TestCheckResourceAttrSet("montecarlo_tableau_integration.test", "credentials.connection_uuid"),
+					resource.TestCheckResourceAttr("montecarlo_tableau_integration.test", "credentials.server_name", tableauServerName),
+					resource.TestCheckResourceAttr("montecarlo_tableau_integration.test", "credentials.site_name", tableauSiteName),
+					resource.TestCheckResourceAttr("montecarlo_tableau_integration.test", "credentials.token_name", tableauTokenName),
+					resource.TestCheckResourceAttr("montecarlo_tableau_integration.test", "credentials.token_value", tableauTokenValue),
+				),
+			},
+			{ // ImportState testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+					"tableau_server_name":      config.StringVariable(tableauServerName),
+					"tableau_site_name":        config.StringVariable(tableauSiteName),
+					"tableau_token_name":       config.StringVariable(tableauTokenName),
+					"tableau_token_value":      config.StringVariable(tableauTokenValue),
+				},
+				ResourceName:      "montecarlo_tableau_integration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					uuid := s.RootModule().Resources["montecarlo_tableau_integration.test"].Primary.Attributes["uuid"]
+					connectionUuid := s.RootModule().Resources["montecarlo_tableau_integration.test"].Primary.Attributes["credentials.connection_uuid"]
+					return fmt.Sprintf("%[1]s,%[2]s,%[3]s", uuid, connectionUuid, collectorUuid), nil
+				},
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ImportStateVerifyIgnore:              []string{"deletion_protection", "credentials"},
+			},
+		},
+	})
+}
@@ -0,0 +1,39 @@
+variable "montecarlo_api_key_id" {
+  type = string
+}
+
+variable "montecarlo_api_key_token" {
+  type = string
+}
+
+provider "montecarlo" {
+  account_service_key = {
+    id    = var.montecarlo_api_key_id     # (secret)
+    token = var.montecarlo_api_key_token  # (secret)
+  }
+}
+
+
+variable "airflow_host_name" {
+  type = string
+}
+
+variable "airflow_username" {
+  type = string
+}
+
+variable "airflow_password" {
+  type = string
+}
+
+resource "montecarlo_airflow_integration" "test" {
+  warehouse_uuid      = "da6c0716-2724-4bfc-b5cc-7e0364faf979"
+  collector_uuid      = "9d1aee0a-6a90-47f0-8221-a884be707fc4"
+  deletion_protection = false
+
+  credentials = {
+    host_name = var.airflow_host_name
+    username  = var.airflow_username  #(secret)
+    password  = var.airflow_password  #(secret)
+  }
+}
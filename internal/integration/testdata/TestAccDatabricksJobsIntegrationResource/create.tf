@@ -0,0 +1,39 @@
+variable "montecarlo_api_key_id" {
+  type = string
+}
+
+variable "montecarlo_api_key_token" {
+  type = string
+}
+
+provider "montecarlo" {
+  account_service_key = {
+    id    = var.montecarlo_api_key_id     # (secret)
+    token = var.montecarlo_api_key_token  # (secret)
+  }
+}
+
+
+variable "databricks_workspace_url" {
+  type = string
+}
+
+variable "databricks_workspace_id" {
+  type = string
+}
+
+variable "databricks_token" {
+  type = string
+}
+
+resource "montecarlo_databricks_jobs_integration" "test" {
+  warehouse_uuid      = "da6c0716-2724-4bfc-b5cc-7e0364faf979"
+  collector_uuid      = "9d1aee0a-6a90-47f0-8221-a884be707fc4"
+  deletion_protection = false
+
+  credentials = {
+    workspace_url = var.databricks_workspace_url
+    workspace_id  = var.databricks_workspace_id
+    token         = var.databricks_token  #(secret)
+  }
+}
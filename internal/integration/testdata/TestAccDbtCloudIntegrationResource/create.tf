@@ -0,0 +1,39 @@
+variable "montecarlo_api_key_id" {
+  type = string
+}
+
+variable "montecarlo_api_key_token" {
+  type = string
+}
+
+provider "montecarlo" {
+  account_service_key = {
+    id    = var.montecarlo_api_key_id     # (secret)
+    token = var.montecarlo_api_key_token  # (secret)
+  }
+}
+
+
+variable "dbt_cloud_account_id" {
+  type = string
+}
+
+variable "dbt_cloud_service_token" {
+  type = string
+}
+
+variable "dbt_cloud_job_id" {
+  type = string
+}
+
+resource "montecarlo_dbt_cloud_integration" "test" {
+  warehouse_uuid      = "da6c0716-2724-4bfc-b5cc-7e0364faf979"
+  webhook_enabled     = false
+  job_ids             = []
+  deletion_protection = false
+
+  credentials = {
+    account_id    = var.dbt_cloud_account_id
+    service_token = var.dbt_cloud_service_token  #(secret)
+  }
+}
@@ -0,0 +1,20 @@
+variable "montecarlo_api_key_id" {
+  type = string
+}
+
+variable "montecarlo_api_key_token" {
+  type = string
+}
+
+provider "montecarlo" {
+  account_service_key = {
+    id    = var.montecarlo_api_key_id     # (secret)
+    token = var.montecarlo_api_key_token  # (secret)
+  }
+}
+
+
+resource "montecarlo_dbt_core_project" "test" {
+  project_name   = "test-project"
+  warehouse_uuid = "da6c0716-2724-4bfc-b5cc-7e0364faf979"
+}
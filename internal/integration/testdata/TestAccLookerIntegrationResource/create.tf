@@ -0,0 +1,39 @@
+variable "montecarlo_api_key_id" {
+  type = string
+}
+
+variable "montecarlo_api_key_token" {
+  type = string
+}
+
+provider "montecarlo" {
+  account_service_key = {
+    id    = var.montecarlo_api_key_id     # (secret)
+    token = var.montecarlo_api_key_token  # (secret)
+  }
+}
+
+
+variable "looker_base_url" {
+  type = string
+}
+
+variable "looker_client_id" {
+  type = string
+}
+
+variable "looker_client_secret" {
+  type = string
+}
+
+resource "montecarlo_looker_integration" "test" {
+  name                = "test-looker"
+  collector_uuid      = "9d1aee0a-6a90-47f0-8221-a884be707fc4"
+  deletion_protection = false
+
+  credentials = {
+    base_url      = var.looker_base_url
+    client_id     = var.looker_client_id      #(secret)
+    client_secret = var.looker_client_secret  #(secret)
+  }
+}
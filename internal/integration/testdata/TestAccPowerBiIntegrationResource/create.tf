@@ -0,0 +1,39 @@
+variable "montecarlo_api_key_id" {
+  type = string
+}
+
+variable "montecarlo_api_key_token" {
+  type = string
+}
+
+provider "montecarlo" {
+  account_service_key = {
+    id    = var.montecarlo_api_key_id     # (secret)
+    token = var.montecarlo_api_key_token  # (secret)
+  }
+}
+
+
+variable "power_bi_tenant_id" {
+  type = string
+}
+
+variable "power_bi_client_id" {
+  type = string
+}
+
+variable "power_bi_client_secret" {
+  type = string
+}
+
+resource "montecarlo_power_bi_integration" "test" {
+  name                = "test-power-bi"
+  collector_uuid      = "9d1aee0a-6a90-47f0-8221-a884be707fc4"
+  deletion_protection = false
+
+  credentials = {
+    tenant_id     = var.power_bi_tenant_id
+    client_id     = var.power_bi_client_id      #(secret)
+    client_secret = var.power_bi_client_secret  #(secret)
+  }
+}
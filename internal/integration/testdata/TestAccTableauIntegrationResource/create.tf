@@ -0,0 +1,44 @@
+variable "montecarlo_api_key_id" {
+  type = string
+}
+
+variable "montecarlo_api_key_token" {
+  type = string
+}
+
+provider "montecarlo" {
+  account_service_key = {
+    id    = var.montecarlo_api_key_id     # (secret)
+    token = var.montecarlo_api_key_token  # (secret)
+  }
+}
+
+
+variable "tableau_server_name" {
+  type = string
+}
+
+variable "tableau_site_name" {
+  type = string
+}
+
+variable "tableau_token_name" {
+  type = string
+}
+
+variable "tableau_token_value" {
+  type = string
+}
+
+resource "montecarlo_tableau_integration" "test" {
+  name                = "test-tableau"
+  collector_uuid      = "9d1aee0a-6a90-47f0-8221-a884be707fc4"
+  deletion_protection = false
+
+  credentials = {
+    server_name = var.tableau_server_name
+    site_name   = var.tableau_site_name
+    token_name  = var.tableau_token_name   #(secret)
+    token_value = var.tableau_token_value  #(secret)
+  }
+}
@@ -0,0 +1,226 @@
+package lineage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LineageEdgeResource{}
+var _ resource.ResourceWithImportState = &LineageEdgeResource{}
+
+// To simplify provider implementations, a named function can be created with the resource implementation.
+func NewLineageEdgeResource() resource.Resource {
+	return &LineageEdgeResource{}
+}
+
+// LineageEdgeResource defines the resource implementation.
+type LineageEdgeResource struct {
+	client client.MonteCarloClient
+}
+
+// LineageEdgeResourceModel describes the resource data model according to its Schema.
+type LineageEdgeResourceModel struct {
+	SourceMcon      common.MconValue `tfsdk:"source_mcon"`
+	DestinationMcon common.MconValue `tfsdk:"destination_mcon"`
+	ExpireAt        types.String     `tfsdk:"expire_at"`
+}
+
+func (r *LineageEdgeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_lineage_edge"
+}
+
+func (r *LineageEdgeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"source_mcon": schema.StringAttribute{
+				Required:   true,
+				CustomType: common.MconType{},
+				Validators: []validator.String{common.MconValidator()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"destination_mcon": schema.StringAttribute{
+				Required:   true,
+				CustomType: common.MconType{},
+				Validators: []validator.String{common.MconValidator()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expire_at": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *LineageEdgeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *LineageEdgeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data LineageEdgeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.createOrUpdate(ctx, &data)...)
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+func (r *LineageEdgeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data LineageEdgeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	source, destination, diags := toNodeInputs(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getResult := client.GetLineageEdge{}
+	variables := map[string]interface{}{"source": source, "destination": destination}
+
+	if bytes, err := r.client.ExecRaw(ctx, client.GetLineageEdgeQuery, variables); err != nil && len(bytes) == 0 {
+		toPrint := fmt.Sprintf("MC client 'GetLineageEdge' query result - %s", err.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+		return
+	} else if jsonErr := json.Unmarshal(bytes, &getResult); jsonErr != nil {
+		toPrint := fmt.Sprintf("MC client 'GetLineageEdge' query failed to unmarshal data - %s", jsonErr.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+		return
+	} else if getResult.GetLineageEdge == nil {
+		toPrint := fmt.Sprintf("MC client 'GetLineageEdge' query failed to find lineage edge [source: %s, destination: %s]. "+
+			"This resource will be removed from the Terraform state without deletion (edge might have expired).",
+			data.SourceMcon.ValueString(), data.DestinationMcon.ValueString())
+		if err != nil {
+			toPrint = fmt.Sprintf("%s - %s", toPrint, err.Error())
+		} // response missing edge data may or may not contain error
+		resp.Diagnostics.AddWarning(toPrint, "")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// default expiration applied by Monte Carlo (expire_at not configured) is not tracked
+	if !data.ExpireAt.IsNull() && !common.SameTimestamp(data.ExpireAt, getResult.GetLineageEdge.ExpireAt) {
+		data.ExpireAt = types.StringPointerValue(getResult.GetLineageEdge.ExpireAt)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LineageEdgeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data LineageEdgeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.createOrUpdate(ctx, &data)...)
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+func (r *LineageEdgeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data LineageEdgeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	source, destination, diags := toNodeInputs(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteResult := client.DeleteLineageEdge{}
+	variables := map[string]interface{}{"source": source, "destination": destination}
+
+	if err := r.client.Mutate(ctx, &deleteResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'DeleteLineageEdge' mutation result - %s", err.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+	} else if !deleteResult.DeleteLineageEdge.Success {
+		toPrint := "MC client 'DeleteLineageEdge' mutation - success = false, " +
+			"lineage edge probably already doesn't exists. This resource will continue with its deletion"
+		resp.Diagnostics.AddWarning(toPrint, "")
+	}
+}
+
+func (r *LineageEdgeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idsImported := strings.Split(req.ID, ",")
+	if len(idsImported) == 2 && idsImported[0] != "" && idsImported[1] != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("source_mcon"), common.NewMconValue(common.NormalizeMcon(idsImported[0])))...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("destination_mcon"), common.NewMconValue(common.NormalizeMcon(idsImported[1])))...)
+	} else {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <source_mcon>,<destination_mcon>. Got: %q", req.ID),
+		)
+	}
+}
+
+func (r *LineageEdgeResource) createOrUpdate(ctx context.Context, data *LineageEdgeResourceModel) diag.Diagnostics {
+	source, destination, diags := toNodeInputs(*data)
+	if diags.HasError() {
+		return diags
+	}
+
+	createResult := client.CreateOrUpdateLineageEdge{}
+	variables := map[string]interface{}{
+		"source":      source,
+		"destination": destination,
+		"expireAt":    (*client.DateTime)(data.ExpireAt.ValueStringPointer()),
+	}
+
+	if err := r.client.Mutate(ctx, &createResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'CreateOrUpdateLineageEdge' mutation result - %s", err.Error())
+		diags.AddError(toPrint, "")
+		return diags
+	}
+	return diags // configured expiration is kept as is, API might return it in a different (but equal) format
+}
+
+// toNodeInputs identifies both ends of the edge the way Monte Carlo API expects it,
+// by the object type, object ID and resource (warehouse) which are parts of the MCON.
+func toNodeInputs(data LineageEdgeResourceModel) (client.NodeInput, client.NodeInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	source, err := common.ParseMcon(data.SourceMcon.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("source_mcon"), "Invalid MCON", err.Error())
+	}
+
+	destination, err := common.ParseMcon(data.DestinationMcon.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("destination_mcon"), "Invalid MCON", err.Error())
+	}
+	return toNodeInput(source), toNodeInput(destination), diags
+}
+
+func toNodeInput(in common.Mcon) client.NodeInput {
+	return client.NodeInput{ObjectType: in.Kind, ObjectId: in.Path, ResourceId: client.UUID(in.ResourceUuid)}
+}
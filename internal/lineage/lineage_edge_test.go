@@ -0,0 +1,76 @@
+package lineage_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccLineageEdgeResource(t *testing.T) {
+	mc_api_key_id := os.Getenv("MC_API_KEY_ID")
+	mc_api_key_token := os.Getenv("MC_API_KEY_TOKEN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{ // Create and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("create.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("montecarlo_lineage_edge.test", "source_mcon", "montecarlo_lineage_node.source", "mcon"),
+					resource.TestCheckResourceAttrPair("montecarlo_lineage_edge.test", "destination_mcon", "montecarlo_lineage_node.destination", "mcon"),
+					resource.TestCheckResourceAttr("montecarlo_lineage_edge.test", "expire_at", "2099-01-01T00:00:00Z"),
+				),
+			},
+			{ // ImportState testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				ResourceName:      "montecarlo_lineage_edge.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					sourceMcon := s.RootModule().Resources["montecarlo_lineage_edge.test"].Primary.Attributes["source_mcon"]
+					destinationMcon := s.RootModule().Resources["montecarlo_lineage_edge.test"].Primary.Attributes["destination_mcon"]
+					return fmt.Sprintf("%[1]s,%[2]s", sourceMcon, destinationMcon), nil
+				},
+				ImportStateVerifyIdentifierAttribute: "source_mcon",
+				ImportStateVerifyIgnore:              []string{"expire_at"},
+			},
+			{ // Update and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("update.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_lineage_edge.test", "expire_at", "2099-12-31T00:00:00Z"),
+				),
+			},
+			{ // Clearing expiration testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("clear.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("montecarlo_lineage_edge.test", "expire_at"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,245 @@
+package lineage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LineageNodeResource{}
+var _ resource.ResourceWithImportState = &LineageNodeResource{}
+
+// To simplify provider implementations, a named function can be created with the resource implementation.
+func NewLineageNodeResource() resource.Resource {
+	return &LineageNodeResource{}
+}
+
+// LineageNodeResource defines the resource implementation.
+type LineageNodeResource struct {
+	client client.MonteCarloClient
+}
+
+// LineageNodeResourceModel describes the resource data model according to its Schema.
+type LineageNodeResourceModel struct {
+	Mcon         common.MconValue `tfsdk:"mcon"`
+	ObjectType   types.String     `tfsdk:"object_type"`
+	ObjectId     types.String     `tfsdk:"object_id"`
+	Name         types.String     `tfsdk:"name"`
+	ResourceUuid types.String     `tfsdk:"resource_uuid"`
+	Properties   types.Map        `tfsdk:"properties"`
+}
+
+func (r *LineageNodeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_lineage_node"
+}
+
+func (r *LineageNodeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"mcon": schema.StringAttribute{
+				Computed:   true,
+				Optional:   false,
+				CustomType: common.MconType{},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"object_type": schema.StringAttribute{
+				Required:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"object_id": schema.StringAttribute{
+				Required:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"resource_uuid": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"properties": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *LineageNodeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *LineageNodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data LineageNodeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mcon, diags := r.createOrUpdate(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Mcon = common.NewMconValue(mcon)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LineageNodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data LineageNodeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getResult := client.GetLineageNode{}
+	variables := map[string]interface{}{"mcon": data.Mcon.ValueString()}
+
+	if bytes, err := r.client.ExecRaw(ctx, client.GetLineageNodeQuery, variables); err != nil && len(bytes) == 0 {
+		toPrint := fmt.Sprintf("MC client 'GetLineageNode' query result - %s", err.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+		return
+	} else if jsonErr := json.Unmarshal(bytes, &getResult); jsonErr != nil {
+		toPrint := fmt.Sprintf("MC client 'GetLineageNode' query failed to unmarshal data - %s", jsonErr.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+		return
+	} else if getResult.GetLineageNode == nil {
+		toPrint := fmt.Sprintf("MC client 'GetLineageNode' query failed to find lineage node [mcon: %s]. "+
+			"This resource will be removed from the Terraform state without deletion.", data.Mcon.ValueString())
+		if err != nil {
+			toPrint = fmt.Sprintf("%s - %s", toPrint, err.Error())
+		} // response missing node data may or may not contain error
+		resp.Diagnostics.AddWarning(toPrint, "")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// object type, object ID and resource are part of the MCON, they are set
+	// from it only when missing in the state (e.g. after import)
+	if data.ObjectType.IsNull() || data.ObjectId.IsNull() || data.ResourceUuid.IsNull() {
+		mcon, err := common.ParseMcon(getResult.GetLineageNode.Mcon)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Invalid MCON %q", getResult.GetLineageNode.Mcon), err.Error())
+			return
+		}
+		data.ResourceUuid = types.StringValue(mcon.ResourceUuid)
+		data.ObjectType = types.StringValue(getResult.GetLineageNode.ObjectType)
+		data.ObjectId = types.StringValue(getResult.GetLineageNode.ObjectId)
+	}
+
+	properties := map[string]string{}
+	for _, property := range getResult.GetLineageNode.Properties {
+		properties[property.PropertyName] = property.PropertyValue
+	}
+
+	if len(properties) > 0 || !data.Properties.IsNull() {
+		propertiesValue, diags := types.MapValueFrom(ctx, types.StringType, properties)
+		resp.Diagnostics.Append(diags...)
+		data.Properties = propertiesValue
+	}
+
+	data.Name = types.StringValue(getResult.GetLineageNode.DisplayName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LineageNodeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data LineageNodeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mcon, diags := r.createOrUpdate(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Mcon = common.NewMconValue(mcon)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LineageNodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data LineageNodeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteResult := client.DeleteLineageNode{}
+	variables := map[string]interface{}{"mcon": data.Mcon.ValueString()}
+
+	if err := r.client.Mutate(ctx, &deleteResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'DeleteLineageNode' mutation result - %s", err.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+	} else if deleteResult.DeleteLineageNode.NodesDeleted != 1 {
+		toPrint := fmt.Sprintf("MC client 'DeleteLineageNode' mutation - nodesDeleted = %d, "+
+			"expected result is 1 - lineage node probably already doesn't exists. This resource "+
+			"will continue with its deletion", deleteResult.DeleteLineageNode.NodesDeleted)
+		resp.Diagnostics.AddWarning(toPrint, "")
+	}
+}
+
+func (r *LineageNodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("mcon"), req, resp)
+}
+
+func (r *LineageNodeResource) createOrUpdate(ctx context.Context, data LineageNodeResourceModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	properties := map[string]string{}
+	if !data.Properties.IsNull() {
+		diags.Append(data.Properties.ElementsAs(ctx, &properties, false)...)
+		if diags.HasError() {
+			return "", diags
+		}
+	}
+
+	propertyInputs := make([]client.ObjectPropertyInput, 0, len(properties))
+	for name, value := range properties {
+		propertyInputs = append(propertyInputs, client.ObjectPropertyInput{PropertyName: name, PropertyValue: value})
+	}
+
+	createResult := client.CreateOrUpdateLineageNode{}
+	variables := map[string]interface{}{
+		"objectType": data.ObjectType.ValueString(),
+		"objectId":   data.ObjectId.ValueString(),
+		"name":       data.Name.ValueString(),
+		"resourceId": client.UUID(data.ResourceUuid.ValueString()),
+		"properties": propertyInputs,
+	}
+
+	if err := r.client.Mutate(ctx, &createResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'CreateOrUpdateLineageNode' mutation result - %s", err.Error())
+		diags.AddError(toPrint, "")
+		return "", diags
+	}
+	return createResult.CreateOrUpdateLineageNode.Node.Mcon, diags
+}
@@ -0,0 +1,67 @@
+package lineage_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccLineageNodeResource(t *testing.T) {
+	mc_api_key_id := os.Getenv("MC_API_KEY_ID")
+	mc_api_key_token := os.Getenv("MC_API_KEY_TOKEN")
+	warehouseUuid := "da6c0716-2724-4bfc-b5cc-7e0364faf979"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{ // Create and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("create.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_lineage_node.test", "object_type", "kafka-topic"),
+					resource.TestCheckResourceAttr("montecarlo_lineage_node.test", "object_id", "terraform-provider-test-topic"),
+					resource.TestCheckResourceAttr("montecarlo_lineage_node.test", "name", "test-topic"),
+					resource.TestCheckResourceAttr("montecarlo_lineage_node.test", "resource_uuid", warehouseUuid),
+					resource.TestCheckNoResourceAttr("montecarlo_lineage_node.test", "properties"),
+					resource.TestCheckResourceAttrSet("montecarlo_lineage_node.test", "mcon"),
+				),
+			},
+			{ // ImportState testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				ResourceName:                         "montecarlo_lineage_node.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "mcon",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["montecarlo_lineage_node.test"].Primary.Attributes["mcon"], nil
+				},
+			},
+			{ // Update and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("update.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_lineage_node.test", "name", "test-topic-updated"),
+					resource.TestCheckResourceAttr("montecarlo_lineage_node.test", "properties.%", "1"),
+					resource.TestCheckResourceAttr("montecarlo_lineage_node.test", "properties.owner", "data-platform"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,33 @@
+variable "montecarlo_api_key_id" {
+  type = string
+}
+
+variable "montecarlo_api_key_token" {
+  type = string
+}
+
+provider "montecarlo" {
+  account_service_key = {
+    id    = var.montecarlo_api_key_id     # (secret)
+    token = var.montecarlo_api_key_token  # (secret)
+  }
+}
+
+resource "montecarlo_lineage_node" "source" {
+  object_type   = "kafka-topic"
+  object_id     = "terraform-provider-test-edge-source"
+  name          = "test-edge-source"
+  resource_uuid = "da6c0716-2724-4bfc-b5cc-7e0364faf979"
+}
+
+resource "montecarlo_lineage_node" "destination" {
+  object_type   = "s3-bucket"
+  object_id     = "terraform-provider-test-edge-destination"
+  name          = "test-edge-destination"
+  resource_uuid = "da6c0716-2724-4bfc-b5cc-7e0364faf979"
+}
+
+resource "montecarlo_lineage_edge" "test" {
+  source_mcon      = montecarlo_lineage_node.source.mcon
+  destination_mcon = montecarlo_lineage_node.destination.mcon
+}
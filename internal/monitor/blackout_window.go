@@ -0,0 +1,335 @@
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BlackoutWindowResource{}
+var _ resource.ResourceWithImportState = &BlackoutWindowResource{}
+var _ resource.ResourceWithConfigValidators = &BlackoutWindowResource{}
+var _ resource.ResourceWithValidateConfig = &BlackoutWindowResource{}
+
+// To simplify provider implementations, a named function can be created with the resource implementation.
+func NewBlackoutWindowResource() resource.Resource {
+	return &BlackoutWindowResource{}
+}
+
+// BlackoutWindowResource defines the resource implementation.
+type BlackoutWindowResource struct {
+	client client.MonteCarloClient
+}
+
+// BlackoutWindowResourceModel describes the resource data model according to its Schema.
+type BlackoutWindowResourceModel struct {
+	Uuid      types.String             `tfsdk:"uuid"`
+	Reason    types.String             `tfsdk:"reason"`
+	StartTime types.String             `tfsdk:"start_time"`
+	EndTime   types.String             `tfsdk:"end_time"`
+	Schedule  *BlackoutScheduleModel   `tfsdk:"schedule"`
+	Scope     BlackoutWindowScopeModel `tfsdk:"scope"`
+}
+
+// BlackoutScheduleModel describes recurring blackout window, starting according to the cron expression.
+type BlackoutScheduleModel struct {
+	Cron            types.String `tfsdk:"cron"`
+	DurationMinutes types.Int64  `tfsdk:"duration_minutes"`
+	Timezone        types.String `tfsdk:"timezone"`
+}
+
+// BlackoutWindowScopeModel describes data objects or monitors whose incidents are suppressed during the window.
+type BlackoutWindowScopeModel struct {
+	Warehouses []types.String     `tfsdk:"warehouses"`
+	Domains    []types.String     `tfsdk:"domains"`
+	Tables     []common.MconValue `tfsdk:"tables"`
+	Monitors   []types.String     `tfsdk:"monitors"`
+}
+
+func (r *BlackoutWindowResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blackout_window"
+}
+
+func (r *BlackoutWindowResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Computed: true,
+				Optional: false,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reason": schema.StringAttribute{
+				Required:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"start_time": schema.StringAttribute{
+				Optional: true,
+			},
+			"end_time": schema.StringAttribute{
+				Optional: true,
+			},
+			"schedule": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"cron": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^\S+( \S+){4}$`),
+								"must be a cron expression with 5 fields (minute hour day-of-month month day-of-week)"),
+						},
+					},
+					"duration_minutes": schema.Int64Attribute{
+						Required:   true,
+						Validators: []validator.Int64{int64validator.AtLeast(1)},
+					},
+					"timezone": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString("UTC"),
+					},
+				},
+			},
+			"scope": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"warehouses": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Validators:  []validator.Set{setvalidator.SizeAtLeast(1)},
+					},
+					"domains": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Validators:  []validator.Set{setvalidator.SizeAtLeast(1)},
+					},
+					"tables": schema.SetAttribute{
+						Optional:    true,
+						ElementType: common.MconType{},
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ValueStringsAre(common.MconValidator()),
+						},
+					},
+					"monitors": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Validators:  []validator.Set{setvalidator.SizeAtLeast(1)},
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(
+						path.MatchRelative().AtName("warehouses"),
+						path.MatchRelative().AtName("domains"),
+						path.MatchRelative().AtName("tables"),
+						path.MatchRelative().AtName("monitors"),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *BlackoutWindowResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(path.MatchRoot("start_time"), path.MatchRoot("schedule")),
+		resourcevalidator.RequiredTogether(path.MatchRoot("start_time"), path.MatchRoot("end_time")),
+	}
+}
+
+func (r *BlackoutWindowResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var startTime, endTime types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("start_time"), &startTime)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("end_time"), &endTime)...)
+	if resp.Diagnostics.HasError() || startTime.IsNull() || startTime.IsUnknown() || endTime.IsNull() || endTime.IsUnknown() {
+		return
+	}
+
+	start, startErr := time.Parse(time.RFC3339, startTime.ValueString())
+	end, endErr := time.Parse(time.RFC3339, endTime.ValueString())
+	if startErr != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("start_time"), "Invalid timestamp", startErr.Error())
+	}
+	if endErr != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("end_time"), "Invalid timestamp", endErr.Error())
+	}
+	if startErr == nil && endErr == nil && !end.After(start) {
+		resp.Diagnostics.AddAttributeError(path.Root("end_time"), "Invalid blackout window",
+			fmt.Sprintf("End of the window (%s) must be after its start (%s).", endTime.ValueString(), startTime.ValueString()))
+	}
+}
+
+func (r *BlackoutWindowResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *BlackoutWindowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BlackoutWindowResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResult := client.CreateOrUpdateBlackoutWindow{}
+	if err := r.client.Mutate(ctx, &createResult, r.variables(data, nil)); err != nil {
+		toPrint := fmt.Sprintf("MC client 'CreateOrUpdateBlackoutWindow' mutation result - %s", err.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+		return
+	}
+
+	data.Uuid = types.StringValue(createResult.CreateOrUpdateBlackoutWindow.BlackoutWindow.Uuid)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BlackoutWindowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BlackoutWindowResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getResult := client.GetBlackoutWindow{}
+	variables := map[string]interface{}{"uuid": client.UUID(data.Uuid.ValueString())}
+
+	if bytes, err := r.client.ExecRaw(ctx, client.GetBlackoutWindowQuery, variables); err != nil && len(bytes) == 0 {
+		toPrint := fmt.Sprintf("MC client 'GetBlackoutWindow' query result - %s", err.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+		return
+	} else if jsonErr := json.Unmarshal(bytes, &getResult); jsonErr != nil {
+		toPrint := fmt.Sprintf("MC client 'GetBlackoutWindow' query failed to unmarshal data - %s", jsonErr.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+		return
+	} else if getResult.GetBlackoutWindow == nil {
+		toPrint := fmt.Sprintf("MC client 'GetBlackoutWindow' query failed to find blackout window [uuid: %s]. "+
+			"This resource will be removed from the Terraform state without deletion.", data.Uuid.ValueString())
+		if err != nil {
+			toPrint = fmt.Sprintf("%s - %s", toPrint, err.Error())
+		} // response missing blackout window data may or may not contain error
+		resp.Diagnostics.AddWarning(toPrint, "")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	window := getResult.GetBlackoutWindow
+	data.Reason = types.StringValue(window.Reason)
+	if !common.SameTimestamp(data.StartTime, window.StartTime) {
+		data.StartTime = types.StringPointerValue(window.StartTime)
+	}
+	if !common.SameTimestamp(data.EndTime, window.EndTime) {
+		data.EndTime = types.StringPointerValue(window.EndTime)
+	}
+
+	data.Schedule = nil
+	if window.Schedule != nil {
+		data.Schedule = &BlackoutScheduleModel{
+			Cron:            types.StringValue(window.Schedule.Cron),
+			DurationMinutes: types.Int64Value(window.Schedule.DurationMinutes),
+			Timezone:        types.StringValue(window.Schedule.Timezone),
+		}
+	}
+
+	data.Scope = BlackoutWindowScopeModel{
+		Warehouses: stringsOrNil(window.Scope.WarehouseUuids),
+		Domains:    stringsOrNil(window.Scope.DomainUuids),
+		Monitors:   stringsOrNil(window.Scope.MonitorUuids),
+	}
+	if len(window.Scope.Mcons) > 0 {
+		data.Scope.Tables = common.MconsFrom(window.Scope.Mcons)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BlackoutWindowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BlackoutWindowResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uuid := client.UUID(data.Uuid.ValueString())
+	updateResult := client.CreateOrUpdateBlackoutWindow{}
+	if err := r.client.Mutate(ctx, &updateResult, r.variables(data, &uuid)); err != nil {
+		toPrint := fmt.Sprintf("MC client 'CreateOrUpdateBlackoutWindow' mutation result - %s", err.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BlackoutWindowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BlackoutWindowResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteResult := client.DeleteBlackoutWindow{}
+	variables := map[string]interface{}{"uuid": client.UUID(data.Uuid.ValueString())}
+	if err := r.client.Mutate(ctx, &deleteResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'DeleteBlackoutWindow' mutation result - %s", err.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+	} else if !deleteResult.DeleteBlackoutWindow.Success {
+		toPrint := "MC client 'DeleteBlackoutWindow' mutation - success = false, " +
+			"blackout window probably already doesn't exists. This resource will continue with its deletion"
+		resp.Diagnostics.AddWarning(toPrint, "")
+	}
+}
+
+func (r *BlackoutWindowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("uuid"), req, resp)
+}
+
+func (r *BlackoutWindowResource) variables(data BlackoutWindowResourceModel, uuid *client.UUID) map[string]interface{} {
+	var schedule *client.BlackoutWindowScheduleInput
+	if data.Schedule != nil {
+		schedule = &client.BlackoutWindowScheduleInput{
+			Cron:            data.Schedule.Cron.ValueString(),
+			DurationMinutes: data.Schedule.DurationMinutes.ValueInt64(),
+			Timezone:        data.Schedule.Timezone.ValueString(),
+		}
+	}
+
+	return map[string]interface{}{
+		"uuid":      uuid,
+		"reason":    data.Reason.ValueString(),
+		"startTime": (*client.DateTime)(data.StartTime.ValueStringPointer()),
+		"endTime":   (*client.DateTime)(data.EndTime.ValueStringPointer()),
+		"schedule":  schedule,
+		"scope": client.BlackoutWindowScopeInput{
+			WarehouseUuids: common.TfStringsTo[client.UUID](data.Scope.Warehouses),
+			DomainUuids:    common.TfStringsTo[client.UUID](data.Scope.Domains),
+			Mcons:          common.MconsTo(data.Scope.Tables),
+			MonitorUuids:   common.TfStringsTo[client.UUID](data.Scope.Monitors),
+		},
+	}
+}
+
+func stringsOrNil(in []string) []types.String {
+	if len(in) == 0 {
+		return nil
+	}
+	return common.TfStringsFrom(in)
+}
@@ -0,0 +1,73 @@
+package monitor_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccBlackoutWindowResource(t *testing.T) {
+	mc_api_key_id := os.Getenv("MC_API_KEY_ID")
+	mc_api_key_token := os.Getenv("MC_API_KEY_TOKEN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{ // Create and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("create.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("montecarlo_blackout_window.test", "uuid"),
+					resource.TestCheckResourceAttr("montecarlo_blackout_window.test", "reason", "Planned warehouse maintenance"),
+					resource.TestCheckResourceAttr("montecarlo_blackout_window.test", "start_time", "2099-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("montecarlo_blackout_window.test", "end_time", "2099-01-01T06:00:00Z"),
+					resource.TestCheckResourceAttr("montecarlo_blackout_window.test", "scope.warehouses.#", "1"),
+					resource.TestCheckResourceAttr("montecarlo_blackout_window.test", "scope.warehouses.0", "da6c0716-2724-4bfc-b5cc-7e0364faf979"),
+					resource.TestCheckNoResourceAttr("montecarlo_blackout_window.test", "schedule"),
+				),
+			},
+			{ // ImportState testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				ResourceName:                         "montecarlo_blackout_window.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ImportStateVerifyIgnore:              []string{"start_time", "end_time"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["montecarlo_blackout_window.test"].Primary.Attributes["uuid"], nil
+				},
+			},
+			{ // Update and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("update.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_blackout_window.test", "reason", "Weekly backfill of the person table"),
+					resource.TestCheckNoResourceAttr("montecarlo_blackout_window.test", "start_time"),
+					resource.TestCheckNoResourceAttr("montecarlo_blackout_window.test", "end_time"),
+					resource.TestCheckResourceAttr("montecarlo_blackout_window.test", "schedule.cron", "0 2 * * 0"),
+					resource.TestCheckResourceAttr("montecarlo_blackout_window.test", "schedule.duration_minutes", "120"),
+					resource.TestCheckResourceAttr("montecarlo_blackout_window.test", "schedule.timezone", "UTC"),
+					resource.TestCheckNoResourceAttr("montecarlo_blackout_window.test", "scope.warehouses"),
+					resource.TestCheckResourceAttr("montecarlo_blackout_window.test", "scope.tables.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,177 @@
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	circuitBreakerDefaultTimeout  = 300 * time.Second
+	circuitBreakerInitialInterval = 5 * time.Second
+	circuitBreakerMaxInterval     = 60 * time.Second
+
+	circuitBreakerStatusComplete = "PROCESSING_COMPLETE"
+	circuitBreakerStatusError    = "HAS_ERROR"
+)
+
+var _ datasource.DataSource = &CircuitBreakerDataSource{}
+
+func NewCircuitBreakerDatasource() datasource.DataSource {
+	return &CircuitBreakerDataSource{}
+}
+
+type CircuitBreakerDataSource struct {
+	client client.MonteCarloClient
+}
+
+type CircuitBreakerDataSourceModel struct {
+	RuleUuid         types.String `tfsdk:"rule_uuid"`
+	TimeoutSeconds   types.Int64  `tfsdk:"timeout_seconds"`
+	JobExecutionUuid types.String `tfsdk:"job_execution_uuid"`
+	Status           types.String `tfsdk:"status"`
+	Breached         types.Bool   `tfsdk:"breached"`
+	BreachCount      types.Int64  `tfsdk:"breach_count"`
+	Log              types.String `tfsdk:"log"`
+}
+
+func (d *CircuitBreakerDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_circuit_breaker"
+}
+
+func (d *CircuitBreakerDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"rule_uuid": schema.StringAttribute{
+				Required: true,
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"job_execution_uuid": schema.StringAttribute{
+				Computed: true,
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+			"breached": schema.BoolAttribute{
+				Computed: true,
+			},
+			"breach_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			"log": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *CircuitBreakerDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	d.client = client
+}
+
+func (d *CircuitBreakerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CircuitBreakerDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := circuitBreakerDefaultTimeout
+	if !data.TimeoutSeconds.IsNull() {
+		timeout = time.Duration(data.TimeoutSeconds.ValueInt64()) * time.Second
+	}
+	pollCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	triggerResult := client.TriggerCircuitBreakerRule{}
+	variables := map[string]interface{}{"ruleUuid": client.UUID(data.RuleUuid.ValueString())}
+	if err := d.client.Mutate(pollCtx, &triggerResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'TriggerCircuitBreakerRule' mutation result - %s", err.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+		return
+	}
+
+	jobExecutionUuid := triggerResult.TriggerCircuitBreakerRule.JobExecutionUuid
+	data.JobExecutionUuid = types.StringValue(jobExecutionUuid)
+	stateResult := client.GetCircuitBreakerRuleState{}
+	variables = map[string]interface{}{"jobExecutionUuid": client.UUID(jobExecutionUuid)}
+
+	// poll the rule run with exponential backoff, until it reaches terminal status or the context is done
+	for interval := circuitBreakerInitialInterval; ; interval = min(2*interval, circuitBreakerMaxInterval) {
+		if err := d.client.Query(pollCtx, &stateResult, variables); err != nil && pollCtx.Err() == nil {
+			toPrint := fmt.Sprintf("MC client 'GetCircuitBreakerRuleState' query result - %s", err.Error())
+			resp.Diagnostics.AddError(toPrint, "")
+			return
+		} else if err == nil {
+			status := stateResult.GetCircuitBreakerRuleState.Status
+			if status == circuitBreakerStatusComplete || status == circuitBreakerStatusError {
+				break
+			}
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-pollCtx.Done():
+			toPrint := fmt.Sprintf("MC client 'GetCircuitBreakerRuleState' circuit breaker rule run [ruleUuid: %s, jobExecutionUuid: %s] "+
+				"did not complete", data.RuleUuid.ValueString(), jobExecutionUuid)
+			if errors.Is(pollCtx.Err(), context.DeadlineExceeded) {
+				toPrint = fmt.Sprintf("%s within %s", toPrint, timeout)
+			} else {
+				toPrint = fmt.Sprintf("%s - %s", toPrint, pollCtx.Err().Error())
+			}
+			resp.Diagnostics.AddError(toPrint, "")
+			return
+		}
+	}
+
+	state := stateResult.GetCircuitBreakerRuleState
+	data.Status = types.StringValue(state.Status)
+	data.Log = types.StringValue(state.Log)
+
+	var logEntries []client.CircuitBreakerLogEntry
+	if state.Log != "" {
+		if err := json.Unmarshal([]byte(state.Log), &logEntries); err != nil {
+			toPrint := fmt.Sprintf("MC client 'GetCircuitBreakerRuleState' query failed to unmarshal log - %s", err.Error())
+			resp.Diagnostics.AddError(toPrint, "")
+			return
+		}
+	}
+
+	if state.Status == circuitBreakerStatusError {
+		toPrint := fmt.Sprintf("MC client 'GetCircuitBreakerRuleState' circuit breaker rule run [ruleUuid: %s, jobExecutionUuid: %s] "+
+			"failed", data.RuleUuid.ValueString(), jobExecutionUuid)
+		for _, entry := range logEntries {
+			if entry.Payload.Error != "" {
+				toPrint = fmt.Sprintf("%s - %s", toPrint, entry.Payload.Error)
+			}
+		}
+		resp.Diagnostics.AddError(toPrint, "")
+		return
+	}
+
+	// last entry reporting number of breaches is the result of the rule run
+	data.BreachCount = types.Int64Value(0)
+	for _, entry := range logEntries {
+		if entry.Payload.BreachCount != nil {
+			data.BreachCount = types.Int64Value(*entry.Payload.BreachCount)
+		}
+	}
+	data.Breached = types.BoolValue(data.BreachCount.ValueInt64() > 0)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
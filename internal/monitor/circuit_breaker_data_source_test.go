@@ -0,0 +1,45 @@
+package monitor_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCircuitBreakerDataSource(t *testing.T) {
+	t.Skip("Currently ignored due to dependency on live custom SQL rule")
+
+	mc_api_key_id := os.Getenv("MC_API_KEY_ID")
+	mc_api_key_token := os.Getenv("MC_API_KEY_TOKEN")
+	ruleUuid := os.Getenv("MC_CIRCUIT_BREAKER_RULE_UUID")
+
+	if ruleUuid == "" {
+		t.Fatalf("'MC_CIRCUIT_BREAKER_RULE_UUID' must be set for this acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{ // Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("read.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+					"rule_uuid":                config.StringVariable(ruleUuid),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.montecarlo_circuit_breaker.test", "rule_uuid", ruleUuid),
+					resource.TestCheckResourceAttrSet("data.montecarlo_circuit_breaker.test", "job_execution_uuid"),
+					resource.TestCheckResourceAttr("data.montecarlo_circuit_breaker.test", "status", "PROCESSING_COMPLETE"),
+					resource.TestCheckResourceAttrSet("data.montecarlo_circuit_breaker.test", "breached"),
+					resource.TestCheckResourceAttrSet("data.montecarlo_circuit_breaker.test", "breach_count"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,24 @@
+variable "montecarlo_api_key_id" {
+  type = string
+}
+
+variable "montecarlo_api_key_token" {
+  type = string
+}
+
+provider "montecarlo" {
+  account_service_key = {
+    id    = var.montecarlo_api_key_id     # (secret)
+    token = var.montecarlo_api_key_token  # (secret)
+  }
+}
+
+
+resource "montecarlo_blackout_window" "test" {
+  reason     = "Planned warehouse maintenance"
+  start_time = "2099-01-01T00:00:00Z"
+  end_time   = "2099-01-01T06:00:00Z"
+  scope = {
+    warehouses = ["da6c0716-2724-4bfc-b5cc-7e0364faf979"]
+  }
+}
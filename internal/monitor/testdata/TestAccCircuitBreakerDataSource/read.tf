@@ -0,0 +1,24 @@
+variable "montecarlo_api_key_id" {
+  type = string
+}
+
+variable "montecarlo_api_key_token" {
+  type = string
+}
+
+provider "montecarlo" {
+  account_service_key = {
+    id    = var.montecarlo_api_key_id     # (secret)
+    token = var.montecarlo_api_key_token  # (secret)
+  }
+}
+
+
+variable "rule_uuid" {
+  type = string
+}
+
+data "montecarlo_circuit_breaker" "test" {
+  rule_uuid       = var.rule_uuid
+  timeout_seconds = 600
+}
@@ -0,0 +1,373 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ObjectPropertiesResource{}
+var _ resource.ResourceWithImportState = &ObjectPropertiesResource{}
+
+// To simplify provider implementations, a named function can be created with the resource implementation.
+func NewObjectPropertiesResource() resource.Resource {
+	return &ObjectPropertiesResource{}
+}
+
+// ObjectPropertiesResource defines the resource implementation.
+type ObjectPropertiesResource struct {
+	client client.MonteCarloClient
+}
+
+// ObjectPropertiesResourceModel describes the resource data model according to its Schema.
+type ObjectPropertiesResourceModel struct {
+	Mcons         []common.MconValue `tfsdk:"mcons"`
+	Tags          []common.TagModel  `tfsdk:"tags"`
+	Authoritative types.Bool         `tfsdk:"authoritative"`
+}
+
+// objectTags holds properties (tags) of the data objects, indexed by MCON and property name.
+type objectTags map[string]map[string]string
+
+func (r *ObjectPropertiesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_properties"
+}
+
+func (r *ObjectPropertiesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"mcons": schema.SetAttribute{
+				Required:    true,
+				ElementType: common.MconType{},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(common.MconValidator()),
+				},
+			},
+			"tags": schema.SetNestedAttribute{
+				Computed: true,
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+						},
+						"value": schema.StringAttribute{
+							Computed: true,
+							Optional: true,
+							Default:  stringdefault.StaticString(""),
+						},
+					},
+				},
+				Default: setdefault.StaticValue(
+					types.SetValueMust(
+						types.ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"name":  types.StringType,
+								"value": types.StringType,
+							},
+						},
+						[]attr.Value{},
+					),
+				),
+			},
+			"authoritative": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *ObjectPropertiesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *ObjectPropertiesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ObjectPropertiesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, nil)...)
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+func (r *ObjectPropertiesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ObjectPropertiesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mcons := common.MconsTo(data.Mcons)
+	observed, diags := r.readTags(ctx, mcons)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// managed tags are kept only if they are still present on all of the data objects, which
+	// leads to their re-assignment on the next apply. After import all common tags are managed
+	managed := common.ToTagPairs(data.Tags)
+	if data.Tags == nil {
+		managed = []client.TagKeyValuePairInput{}
+		for name, value := range observed[mcons[0]] {
+			managed = append(managed, client.TagKeyValuePairInput{Name: name, Value: value})
+		}
+	}
+
+	tags := []client.TagKeyValuePairOutput{}
+	for _, tag := range managed {
+		if observed.presentOnAll(mcons, tag.Name, tag.Value) {
+			tags = append(tags, client.TagKeyValuePairOutput(tag))
+		}
+	}
+
+	// in authoritative mode any other (unmanaged) tag is reported as well, leading to its removal
+	if data.Authoritative.ValueBool() {
+		seen := map[client.TagKeyValuePairOutput]struct{}{}
+		for _, tag := range managed {
+			seen[client.TagKeyValuePairOutput(tag)] = struct{}{}
+		}
+
+		for _, mcon := range mcons {
+			for name, value := range observed[mcon] {
+				tag := client.TagKeyValuePairOutput{Name: name, Value: value}
+				if _, ok := seen[tag]; !ok {
+					seen[tag] = struct{}{}
+					tags = append(tags, tag)
+				}
+			}
+		}
+	}
+
+	if data.Authoritative.IsNull() {
+		data.Authoritative = types.BoolValue(false)
+	}
+
+	data.Tags = common.FromTagPairs(tags)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ObjectPropertiesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ObjectPropertiesResourceModel
+	var prior ObjectPropertiesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, &prior)...)
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+func (r *ObjectPropertiesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ObjectPropertiesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	removals := map[string]map[string]struct{}{}
+	for _, mcon := range common.MconsTo(data.Mcons) {
+		removals[mcon] = map[string]struct{}{}
+		for _, tag := range data.Tags {
+			removals[mcon][tag.Name.ValueString()] = struct{}{}
+		}
+	}
+	resp.Diagnostics.Append(r.removeTags(ctx, removals)...)
+}
+
+func (r *ObjectPropertiesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	mcons := []common.MconValue{}
+	for _, mcon := range strings.Split(req.ID, ",") {
+		if _, err := common.ParseMcon(mcon); err != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected import identifier with format: <mcon>[,<mcon>...]. Got: %q - %s", req.ID, err.Error()),
+			)
+			return
+		}
+		mcons = append(mcons, common.NewMconValue(common.NormalizeMcon(mcon)))
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mcons"), mcons)...)
+}
+
+// apply assigns configured tags to all of the data objects and removes tags which are no longer managed,
+// either from the prior state or (in authoritative mode) any other tags of the data objects.
+func (r *ObjectPropertiesResource) apply(ctx context.Context, data ObjectPropertiesResourceModel, prior *ObjectPropertiesResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	mcons := common.MconsTo(data.Mcons)
+	tags := common.ToTagPairs(data.Tags)
+
+	desired := map[string]struct{}{}
+	for _, tag := range tags {
+		if _, ok := desired[tag.Name]; ok {
+			diags.AddAttributeError(path.Root("tags"), "Duplicate tag name",
+				fmt.Sprintf("Tag %q is configured more than once, data object can hold only single value of the tag.", tag.Name))
+			return diags
+		}
+		desired[tag.Name] = struct{}{}
+	}
+
+	unresolved, unresolvedDiags := common.FindUnresolvedMcons(ctx, r.client, mcons)
+	diags.Append(unresolvedDiags...)
+	if diags.HasError() {
+		return diags
+	} else if len(unresolved) > 0 {
+		diags.AddAttributeError(path.Root("mcons"),
+			fmt.Sprintf("MCONs [%d] do not resolve to existing data objects", len(unresolved)),
+			fmt.Sprintf("Following MCONs were not found in Monte Carlo (or are deleted/excluded from collection):\n  - %s",
+				strings.Join(unresolved, "\n  - ")))
+		return diags
+	}
+
+	removals := map[string]map[string]struct{}{}
+	addRemoval := func(mcon, name string) {
+		if _, ok := removals[mcon]; !ok {
+			removals[mcon] = map[string]struct{}{}
+		}
+		removals[mcon][name] = struct{}{}
+	}
+
+	if prior != nil {
+		current := data.mconSet()
+		for _, mcon := range common.MconsTo(prior.Mcons) {
+			_, keep := current[mcon]
+			for _, tag := range prior.Tags {
+				if _, ok := desired[tag.Name.ValueString()]; !ok || !keep {
+					addRemoval(mcon, tag.Name.ValueString())
+				}
+			}
+		}
+	}
+
+	if data.Authoritative.ValueBool() {
+		observed, readDiags := r.readTags(ctx, mcons)
+		diags.Append(readDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		for mcon, properties := range observed {
+			for name := range properties {
+				if _, ok := desired[name]; !ok {
+					addRemoval(mcon, name)
+				}
+			}
+		}
+	}
+
+	// configured tags are assigned before the removals, so that failed apply does not leave data objects untagged
+	if len(tags) > 0 {
+		inputs := make([]client.InputObjectProperty, 0, len(mcons)*len(tags))
+		for _, mcon := range mcons {
+			for _, tag := range tags {
+				inputs = append(inputs, client.InputObjectProperty{MconId: mcon, PropertyName: tag.Name, PropertyValue: tag.Value})
+			}
+		}
+
+		updateResult := client.BulkCreateOrUpdateObjectProperties{}
+		variables := map[string]interface{}{"inputObjectProperties": inputs}
+		if err := r.client.Mutate(ctx, &updateResult, variables); err != nil {
+			toPrint := fmt.Sprintf("MC client 'BulkCreateOrUpdateObjectProperties' mutation result - %s", err.Error())
+			diags.AddError(toPrint, "")
+			return diags
+		} else if !updateResult.BulkCreateOrUpdateObjectProperties.Success {
+			toPrint := "MC client 'BulkCreateOrUpdateObjectProperties' mutation - success = false, " +
+				"Monte Carlo failed to assign tags to the data objects."
+			diags.AddError(toPrint, "")
+			return diags
+		}
+	}
+
+	diags.Append(r.removeTags(ctx, removals)...)
+	return diags
+}
+
+func (r *ObjectPropertiesResource) readTags(ctx context.Context, mcons []string) (objectTags, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	observed := objectTags{}
+	for _, mcon := range mcons {
+		observed[mcon] = map[string]string{}
+		variables := map[string]interface{}{"mconId": mcon, "first": 500, "after": (*string)(nil)}
+		for hasNextPage := true; hasNextPage; {
+			getResult := client.GetObjectProperties{}
+			if err := r.client.Query(ctx, &getResult, variables); err != nil {
+				toPrint := fmt.Sprintf("MC client 'GetObjectProperties' query result - %s", err.Error())
+				diags.AddError(toPrint, "")
+				return nil, diags
+			}
+
+			hasNextPage = getResult.GetObjectProperties.PageInfo.HasNextPage
+			variables["after"] = getResult.GetObjectProperties.PageInfo.EndCursor
+			for _, edge := range getResult.GetObjectProperties.Edges {
+				observed[mcon][edge.Node.PropertyName] = edge.Node.PropertyValue
+			}
+		}
+	}
+	return observed, diags
+}
+
+func (r *ObjectPropertiesResource) removeTags(ctx context.Context, removals map[string]map[string]struct{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for mcon, names := range removals {
+		for name := range names {
+			deleteResult := client.DeleteObjectProperty{}
+			variables := map[string]interface{}{"mconId": mcon, "propertyName": name}
+			if err := r.client.Mutate(ctx, &deleteResult, variables); err != nil {
+				toPrint := fmt.Sprintf("MC client 'DeleteObjectProperty' mutation result - %s", err.Error())
+				diags.AddError(toPrint, "")
+				return diags
+			} else if !deleteResult.DeleteObjectProperty.Success {
+				toPrint := fmt.Sprintf("MC client 'DeleteObjectProperty' mutation - success = false, "+
+					"tag %q probably already doesn't exists [mcon: %s]. This resource will continue", name, mcon)
+				diags.AddWarning(toPrint, "")
+			}
+		}
+	}
+	return diags
+}
+
+func (m ObjectPropertiesResourceModel) mconSet() map[string]struct{} {
+	result := map[string]struct{}{}
+	for _, mcon := range common.MconsTo(m.Mcons) {
+		result[mcon] = struct{}{}
+	}
+	return result
+}
+
+func (o objectTags) presentOnAll(mcons []string, name, value string) bool {
+	for _, mcon := range mcons {
+		if observedValue, ok := o[mcon][name]; !ok || observedValue != value {
+			return false
+		}
+	}
+	return true
+}
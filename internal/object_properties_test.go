@@ -0,0 +1,77 @@
+package internal_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccObjectPropertiesResource(t *testing.T) {
+	mc_api_key_id := os.Getenv("MC_API_KEY_ID")
+	mc_api_key_token := os.Getenv("MC_API_KEY_TOKEN")
+	personMcon := "MCON++3e9abc75-5dc1-447e-b4cb-9d5a6fc5db5c++da6c0716-2724-4bfc-b5cc-7e0364faf979++table++data-playground-8bb9fc23:terraform_provider_montecarlo.person"
+	deviceMcon := "MCON++3e9abc75-5dc1-447e-b4cb-9d5a6fc5db5c++da6c0716-2724-4bfc-b5cc-7e0364faf979++table++data-playground-8bb9fc23:terraform_provider_montecarlo.device"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{ // Create and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("create.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_object_properties.test", "mcons.#", "1"),
+					resource.TestCheckTypeSetElemAttr("montecarlo_object_properties.test", "mcons.*", personMcon),
+					resource.TestCheckResourceAttr("montecarlo_object_properties.test", "authoritative", "false"),
+					resource.TestCheckResourceAttr("montecarlo_object_properties.test", "tags.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("montecarlo_object_properties.test", "tags.*", map[string]string{
+						"name":  "owner",
+						"value": "bi-internal",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("montecarlo_object_properties.test", "tags.*", map[string]string{
+						"name":  "tf-test",
+						"value": "",
+					}),
+				),
+			},
+			{ // ImportState testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				ResourceName:                         "montecarlo_object_properties.test",
+				ImportState:                          true,
+				ImportStateId:                        personMcon,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "authoritative",
+				ImportStateVerifyIgnore:              []string{"tags"},
+			},
+			{ // Update and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("update.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_object_properties.test", "mcons.#", "2"),
+					resource.TestCheckTypeSetElemAttr("montecarlo_object_properties.test", "mcons.*", deviceMcon),
+					resource.TestCheckResourceAttr("montecarlo_object_properties.test", "authoritative", "true"),
+					resource.TestCheckResourceAttr("montecarlo_object_properties.test", "tags.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("montecarlo_object_properties.test", "tags.*", map[string]string{
+						"name":  "owner",
+						"value": "data-platform",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -7,9 +7,13 @@ import (
 	"github.com/kiwicom/terraform-provider-montecarlo/client"
 	"github.com/kiwicom/terraform-provider-montecarlo/internal/authorization"
 	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/integration"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/lineage"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/monitor"
 	"github.com/kiwicom/terraform-provider-montecarlo/internal/warehouse"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -19,6 +23,7 @@ import (
 
 // Ensure Provider satisfies various provider interfaces.
 var _ provider.Provider = &Provider{}
+var _ provider.ProviderWithEphemeralResources = &Provider{}
 
 type Provider struct {
 	// version is set to the provider version on release, "dev" when the
@@ -74,6 +79,7 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	if p.context != nil {
 		resp.DataSourceData = p.context
 		resp.ResourceData = p.context
+		resp.EphemeralResourceData = p.context
 		return
 	}
 
@@ -89,16 +95,35 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	p.context = &common.ProviderContext{MonteCarloClient: client}
 	resp.DataSourceData = p.context
 	resp.ResourceData = p.context
+	resp.EphemeralResourceData = p.context
 }
 
 func (p *Provider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		warehouse.NewBigQueryWarehouseResource,
 		warehouse.NewTransactionalWarehouseResource,
+		warehouse.NewCollectionBlockResource,
+		warehouse.NewKeyAssetResource,
+		integration.NewLookerIntegrationResource,
+		integration.NewTableauIntegrationResource,
+		integration.NewPowerBiIntegrationResource,
+		integration.NewDbtCloudIntegrationResource,
+		integration.NewAirflowIntegrationResource,
+		integration.NewFivetranIntegrationResource,
+		integration.NewDatabricksJobsIntegrationResource,
+		integration.NewDbtCoreProjectResource,
 		NewDomainResource,
+		NewDataProductResource,
+		NewObjectPropertiesResource,
+		lineage.NewLineageNodeResource,
+		lineage.NewLineageEdgeResource,
 		authorization.NewIamGroupResource,
 		authorization.NewIamMemberResource,
+		authorization.NewIamGroupMembersResource,
+		authorization.NewUserInviteResource,
+		authorization.NewIamRoleResource,
 		//monitor.NewComparisonMonitorResource,
+		monitor.NewBlackoutWindowResource,
 		authorization.NewServiceAccountResource,
 	}
 }
@@ -106,6 +131,20 @@ func (p *Provider) Resources(ctx context.Context) []func() resource.Resource {
 func (p *Provider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		warehouse.NewWarehouseDatasource,
+		warehouse.NewKeyAssetsDatasource,
+		monitor.NewCircuitBreakerDatasource,
+		NewDomainDatasource,
+		NewDomainsDatasource,
+		authorization.NewUserDatasource,
+		authorization.NewUsersDatasource,
+		authorization.NewIamGroupsDatasource,
+	}
+}
+
+func (p *Provider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		authorization.NewServiceAccountTokenEphemeralResource,
+		warehouse.NewCredentialsCheckEphemeralResource,
 	}
 }
 
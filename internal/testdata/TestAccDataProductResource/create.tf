@@ -0,0 +1,35 @@
+variable "montecarlo_api_key_id" {
+  type = string
+}
+
+variable "montecarlo_api_key_token" {
+  type = string
+}
+
+provider "montecarlo" {
+  account_service_key = {
+    id    = var.montecarlo_api_key_id     # (secret)
+    token = var.montecarlo_api_key_token  # (secret)
+  }
+}
+
+
+resource "montecarlo_domain" "test" {
+  name        = "data-product-domain"
+  description = "Domain owning the data product"
+}
+
+resource "montecarlo_data_product" "test" {
+  name        = "data-product1"
+  description = "Data product test description"
+  domain_uuid = montecarlo_domain.test.uuid
+  assets = [
+    "MCON++3e9abc75-5dc1-447e-b4cb-9d5a6fc5db5c++da6c0716-2724-4bfc-b5cc-7e0364faf979++table++data-playground-8bb9fc23:terraform_provider_montecarlo.person",
+  ]
+  tags = [
+    {
+      name  = "owner"
+      value = "bi-internal"
+    }
+  ]
+}
@@ -0,0 +1,33 @@
+variable "montecarlo_api_key_id" {
+  type = string
+}
+
+variable "montecarlo_api_key_token" {
+  type = string
+}
+
+provider "montecarlo" {
+  account_service_key = {
+    id    = var.montecarlo_api_key_id     # (secret)
+    token = var.montecarlo_api_key_token  # (secret)
+  }
+}
+
+resource "montecarlo_domain" "test" {
+  name        = "TestAccDomainDataSource"
+  description = "Domain data source test description"
+  tags = [
+    {
+      name  = "owner"
+      value = "bi-internal"
+    }
+  ]
+}
+
+data "montecarlo_domain" "by_uuid" {
+  uuid = montecarlo_domain.test.uuid
+}
+
+data "montecarlo_domain" "by_name" {
+  name = montecarlo_domain.test.name
+}
@@ -0,0 +1,23 @@
+variable "montecarlo_api_key_id" {
+  type = string
+}
+
+variable "montecarlo_api_key_token" {
+  type = string
+}
+
+provider "montecarlo" {
+  account_service_key = {
+    id    = var.montecarlo_api_key_id     # (secret)
+    token = var.montecarlo_api_key_token  # (secret)
+  }
+}
+
+resource "montecarlo_domain" "test" {
+  name        = "TestAccDomainsDataSource"
+  description = "Domains data source test description"
+}
+
+data "montecarlo_domains" "test" {
+  depends_on = [montecarlo_domain.test]
+}
@@ -43,11 +43,13 @@ type BigQueryWarehouseResourceModel struct {
 	Name               types.String  `tfsdk:"name"`
 	CollectorUuid      types.String  `tfsdk:"collector_uuid"`
 	DeletionProtection types.Bool    `tfsdk:"deletion_protection"`
+	CredentialsDrifted types.Bool    `tfsdk:"credentials_drifted"`
 }
 
 type BqCredentials struct {
 	ConnectionUuid    types.String `tfsdk:"connection_uuid"`
 	ServiceAccountKey types.String `tfsdk:"service_account_key"`
+	Project           types.String `tfsdk:"project"`
 	UpdatedAt         types.String `tfsdk:"updated_at"`
 }
 
@@ -105,6 +107,10 @@ func (r *BigQueryWarehouseResource) Schema(ctx context.Context, req resource.Sch
 						Required:  true,
 						Sensitive: true,
 					},
+					"project": schema.StringAttribute{
+						Computed: true,
+						Optional: false,
+					},
 					"updated_at": schema.StringAttribute{
 						Computed: true,
 						Optional: false,
@@ -126,6 +132,13 @@ func (r *BigQueryWarehouseResource) Schema(ctx context.Context, req resource.Sch
 				Computed: true,
 				Default:  booldefault.StaticBool(true),
 			},
+			"credentials_drifted": schema.BoolAttribute{
+				Computed: true,
+				Optional: false,
+				PlanModifiers: []planmodifier.Bool{
+					common.RotateCredentialsIfDrifted(),
+				},
+			},
 		},
 	}
 }
@@ -152,6 +165,8 @@ func (r *BigQueryWarehouseResource) Create(ctx context.Context, req resource.Cre
 	data.Uuid = types.StringValue(result.AddConnection.Connection.Warehouse.Uuid)
 	data.Credentials.UpdatedAt = types.StringValue(result.AddConnection.Connection.CreatedOn)
 	data.Credentials.ConnectionUuid = types.StringValue(result.AddConnection.Connection.Uuid)
+	data.Credentials.Project = bqProject(data.Credentials.ServiceAccountKey)
+	data.CredentialsDrifted = types.BoolValue(false)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -198,6 +213,7 @@ func (r *BigQueryWarehouseResource) Read(ctx context.Context, req resource.ReadR
 
 	readConnectionUuid := types.StringNull()
 	readConnectionSAKey := types.StringNull()
+	readConnectionProject := types.StringNull()
 	readConnectionUpdatedAt := types.StringNull()
 
 	for _, connection := range getResult.GetWarehouse.Connections {
@@ -212,6 +228,10 @@ func (r *BigQueryWarehouseResource) Read(ctx context.Context, req resource.ReadR
 
 			readConnectionUuid = data.Credentials.ConnectionUuid
 			readConnectionSAKey = data.Credentials.ServiceAccountKey
+			readConnectionProject = data.Credentials.Project
+			if connection.Details.Project != nil {
+				readConnectionProject = types.StringValue(*connection.Details.Project)
+			}
 			readConnectionUpdatedAt = types.StringValue(connection.UpdatedOn)
 			if connection.UpdatedOn == "" {
 				readConnectionUpdatedAt = types.StringValue(connection.CreatedOn)
@@ -219,13 +239,11 @@ func (r *BigQueryWarehouseResource) Read(ctx context.Context, req resource.ReadR
 		}
 	}
 
-	if !readConnectionSAKey.IsNull() && !readConnectionUpdatedAt.Equal(data.Credentials.UpdatedAt) {
-		readConnectionSAKey = types.StringValue("(unknown external value)")
-	}
-
+	data.CredentialsDrifted = common.CredentialsDrifted(data.CredentialsDrifted, data.Credentials.UpdatedAt, readConnectionUpdatedAt)
 	data.Credentials.UpdatedAt = readConnectionUpdatedAt
 	data.Credentials.ConnectionUuid = readConnectionUuid
 	data.Credentials.ServiceAccountKey = readConnectionSAKey
+	data.Credentials.Project = readConnectionProject
 	data.Name = types.StringValue(getResult.GetWarehouse.Name)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -263,7 +281,10 @@ func (r *BigQueryWarehouseResource) Update(ctx context.Context, req resource.Upd
 	if updateResult, diags := updateConnection(ctx, r.client, r, data, BqKeyExtractor); updateResult == nil {
 		resp.Diagnostics.Append(diags...)
 	} else {
+		resp.Diagnostics.Append(diags...) // non-blocking credentials warnings
 		data.Credentials.UpdatedAt = types.StringValue(updateResult.UpdateCredentialsV2.UpdatedAt)
+		data.Credentials.Project = bqProject(data.Credentials.ServiceAccountKey)
+		data.CredentialsDrifted = types.BoolValue(false)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	}
 }
@@ -311,6 +332,23 @@ func (r *BigQueryWarehouseResource) ImportState(ctx context.Context, req resourc
 }
 
 func (r *BigQueryWarehouseResource) testCredentials(ctx context.Context, data BigQueryWarehouseResourceModel) (*client.TestBqCredentialsV2, diag.Diagnostics) {
+	testResult, diagsResult := testBqCredentials(ctx, r.client, data.CollectorUuid.ValueString(), data.Credentials.ServiceAccountKey.ValueString())
+	if testResult == nil {
+		return nil, diagsResult
+	}
+
+	// non-blocking warnings (e.g. missing query logs permissions) are reported even on success
+	attribute := path.Root("credentials").AtName("service_account_key")
+	diagsResult.Append(bqTestDiagnosticToDiags(testResult.TestBqCredentialsV2.ValidationResult.Warnings, attribute)...)
+	if !testResult.TestBqCredentialsV2.ValidationResult.Success {
+		diagsResult.Append(bqTestDiagnosticToDiags(testResult.TestBqCredentialsV2.ValidationResult.Errors, attribute)...)
+		return nil, diagsResult
+	}
+	return testResult, diagsResult
+}
+
+// testBqCredentials only reports failures of the API call itself, validation result is left to the caller.
+func testBqCredentials(ctx context.Context, mcClient client.MonteCarloClient, collectorUuid string, serviceAccountKey string) (*client.TestBqCredentialsV2, diag.Diagnostics) {
 	var diagsResult diag.Diagnostics
 	type BqConnectionDetails map[string]interface{}
 	type ConnectionTestOptions map[string]interface{}
@@ -318,44 +356,55 @@ func (r *BigQueryWarehouseResource) testCredentials(ctx context.Context, data Bi
 	variables := map[string]interface{}{
 		"validationName": "save_credentials",
 		"connectionDetails": BqConnectionDetails{
-			"serviceJson": b64.StdEncoding.EncodeToString(
-				[]byte(data.Credentials.ServiceAccountKey.ValueString()),
-			),
+			"serviceJson": b64.StdEncoding.EncodeToString([]byte(serviceAccountKey)),
 		},
 		"connectionOptions": ConnectionTestOptions{
-			"dcId": client.UUID(data.CollectorUuid.ValueString()),
+			"dcId": client.UUID(collectorUuid),
 		},
 	}
 
-	if err := r.client.Mutate(ctx, &testResult, variables); err != nil {
+	if err := mcClient.Mutate(ctx, &testResult, variables); err != nil {
 		toPrint := fmt.Sprintf("MC client 'TestBqCredentialsV2' mutation result - %s", err.Error())
 		diagsResult.AddError(toPrint, "")
 		return nil, diagsResult
-	} else if !testResult.TestBqCredentialsV2.ValidationResult.Success {
-		diags := bqTestDiagnosticToDiags(testResult.TestBqCredentialsV2.ValidationResult.Warnings)
-		diags = append(diags, bqTestDiagnosticToDiags(testResult.TestBqCredentialsV2.ValidationResult.Errors)...)
-		diagsResult.Append(diags...)
-		return nil, diagsResult
-	} else {
-		return &testResult, diagsResult
 	}
+	return &testResult, diagsResult
 }
 
-func bqTestDiagnosticToDiags[T client.BqTestWarnings | client.BqTestErrors](in T) diag.Diagnostics {
+// bqProject extracts GCP project of the service account key, the project stays unknown
+// to the state (null) if the key cannot be parsed.
+func bqProject(serviceAccountKey types.String) types.String {
+	var key struct {
+		ProjectId *string `json:"project_id"`
+	}
+	if err := json.Unmarshal([]byte(serviceAccountKey.ValueString()), &key); err != nil {
+		return types.StringNull()
+	}
+	return types.StringPointerValue(key.ProjectId)
+}
+
+func bqTestDiagnosticToDiags[T client.BqTestWarnings | client.BqTestErrors](in T, attribute path.Path) diag.Diagnostics {
 	var diags diag.Diagnostics
 	switch any(in).(type) {
 	case client.BqTestWarnings:
 		for _, value := range in {
-			diags.AddWarning(value.FriendlyMessage, value.Resolution)
+			diags.AddAttributeWarning(attribute, value.FriendlyMessage, bqTestDiagnosticDetail(value))
 		}
 	case client.BqTestErrors:
 		for _, value := range in {
-			diags.AddError(value.FriendlyMessage, value.Resolution)
+			diags.AddAttributeError(attribute, value.FriendlyMessage, bqTestDiagnosticDetail(value))
 		}
 	}
 	return diags
 }
 
+func bqTestDiagnosticDetail(in client.BqTestDiagnostic) string {
+	if in.Cause == "" {
+		return in.Resolution
+	}
+	return fmt.Sprintf("%s\n\nCause: %s", in.Resolution, in.Cause)
+}
+
 func (r *BigQueryWarehouseResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	return map[int64]resource.StateUpgrader{
 		0: {
@@ -459,9 +508,11 @@ func (r *BigQueryWarehouseResource) UpgradeState(ctx context.Context) map[int64]
 						CollectorUuid:      priorStateData.CollectorUuid,
 						Name:               priorStateData.Name,
 						DeletionProtection: priorStateData.DeletionProtection,
+						CredentialsDrifted: types.BoolValue(false),
 						Credentials: BqCredentials{
 							ConnectionUuid:    priorStateData.ConnectionUuid,
 							ServiceAccountKey: priorStateData.ServiceAccountKey,
+							Project:           bqProject(priorStateData.ServiceAccountKey),
 							UpdatedAt:         types.StringNull(),
 						},
 					}
@@ -39,6 +39,8 @@ func TestAccBigQueryWarehouseResource(t *testing.T) {
 					resource.TestCheckResourceAttr("montecarlo_bigquery_warehouse.test", "collector_uuid", collectorUuid),
 					resource.TestCheckResourceAttr("montecarlo_bigquery_warehouse.test", "credentials.service_account_key", serviceAccount),
 					resource.TestCheckResourceAttr("montecarlo_bigquery_warehouse.test", "deletion_protection", "false"),
+					resource.TestCheckResourceAttr("montecarlo_bigquery_warehouse.test", "credentials_drifted", "false"),
+					resource.TestCheckResourceAttrSet("montecarlo_bigquery_warehouse.test", "credentials.project"),
 				),
 			},
 			{ // ImportState testing
@@ -72,6 +74,8 @@ func TestAccBigQueryWarehouseResource(t *testing.T) {
 					resource.TestCheckResourceAttr("montecarlo_bigquery_warehouse.test", "collector_uuid", collectorUuid),
 					resource.TestCheckResourceAttr("montecarlo_bigquery_warehouse.test", "credentials.service_account_key", serviceAccount),
 					resource.TestCheckResourceAttr("montecarlo_bigquery_warehouse.test", "deletion_protection", "false"),
+					resource.TestCheckResourceAttr("montecarlo_bigquery_warehouse.test", "credentials_drifted", "false"),
+					resource.TestCheckResourceAttrSet("montecarlo_bigquery_warehouse.test", "credentials.project"),
 				),
 			},
 		},
@@ -0,0 +1,361 @@
+package warehouse
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const collectionBlockInclude = "INCLUDE"
+const collectionBlockExclude = "EXCLUDE"
+
+// Scratch and PII datasets (schemas) must never be collected by Monte Carlo. Their names are matched
+// by this pattern (e.g. `scratch`, `team_scratch`, `pii_customers`) and rules including them are rejected,
+// same as INCLUDE rules not targeting a specific dataset (they would include protected datasets as well).
+var protectedDatasetRegex = regexp.MustCompile(`(?i)(^|[_.-])(scratch|pii)([_.-]|$)`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CollectionBlockResource{}
+var _ resource.ResourceWithImportState = &CollectionBlockResource{}
+var _ resource.ResourceWithConfigValidators = &CollectionBlockResource{}
+var _ resource.ResourceWithValidateConfig = &CollectionBlockResource{}
+var _ resource.ResourceWithModifyPlan = &CollectionBlockResource{}
+
+// To simplify provider implementations, a named function can be created with the resource implementation.
+func NewCollectionBlockResource() resource.Resource {
+	return &CollectionBlockResource{}
+}
+
+// CollectionBlockResource defines the resource implementation.
+type CollectionBlockResource struct {
+	client client.MonteCarloClient
+}
+
+// CollectionBlockResourceModel describes the resource data model according to its Schema.
+type CollectionBlockResourceModel struct {
+	Uuid               types.String   `tfsdk:"uuid"`
+	WarehouseUuid      types.String   `tfsdk:"warehouse_uuid"`
+	Mode               types.String   `tfsdk:"mode"`
+	Project            types.String   `tfsdk:"project"`
+	Dataset            types.String   `tfsdk:"dataset"`
+	TablePattern       types.String   `tfsdk:"table_pattern"`
+	CollectionTypes    []types.String `tfsdk:"collection_types"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+}
+
+func (r *CollectionBlockResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collection_block"
+}
+
+func (r *CollectionBlockResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Computed: true,
+				Optional: false,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"warehouse_uuid": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(collectionBlockExclude),
+				Validators: []validator.String{
+					stringvalidator.OneOf(collectionBlockInclude, collectionBlockExclude),
+				},
+			},
+			"project": schema.StringAttribute{
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"dataset": schema.StringAttribute{
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"table_pattern": schema.StringAttribute{
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"collection_types": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Default: setdefault.StaticValue(
+					types.SetValueMust(
+						types.StringType,
+						[]attr.Value{types.StringValue("METADATA"), types.StringValue("QUERY_LOGS")},
+					),
+				),
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("METADATA", "QUERY_LOGS")),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *CollectionBlockResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("project"),
+			path.MatchRoot("dataset"),
+			path.MatchRoot("table_pattern"),
+		),
+	}
+}
+
+func (r *CollectionBlockResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CollectionBlockResourceModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("mode"), &data.Mode)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("dataset"), &data.Dataset)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("table_pattern"), &data.TablePattern)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.TablePattern.IsNull() && !data.TablePattern.IsUnknown() {
+		if _, err := regexp.Compile(data.TablePattern.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("table_pattern"), "Invalid table pattern", err.Error())
+		}
+	}
+
+	resp.Diagnostics.Append(validateInclusion(data)...)
+}
+
+// ModifyPlan validates final values of the rule (unknown during validation), and protects exclusions
+// of scratch and PII datasets from being changed, unless deletion_protection was disabled.
+func (r *CollectionBlockResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return // deletion is guarded in Delete
+	}
+
+	var plan CollectionBlockResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateInclusion(plan)...)
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state CollectionBlockResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.DeletionProtection.ValueBool() && state.excludesProtectedDataset() && (!plan.Mode.Equal(state.Mode) ||
+		!plan.Project.Equal(state.Project) || !plan.Dataset.Equal(state.Dataset) || !plan.TablePattern.Equal(state.TablePattern) ||
+		!plan.collectsNoneOf(state.CollectionTypes)) {
+		resp.Diagnostics.AddError(
+			"Failed to change collection block because deletion_protection is set to true. "+
+				"Set it to false to proceed with collection block change",
+			fmt.Sprintf("Collection block excludes scratch or PII dataset %q, its change could re-enable collection "+
+				"of the dataset by Monte Carlo.", state.Dataset.ValueString()),
+		)
+	}
+}
+
+func (r *CollectionBlockResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *CollectionBlockResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CollectionBlockResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(validateInclusion(data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResult := client.CreateOrUpdateCollectionBlock{}
+	if err := r.client.Mutate(ctx, &createResult, r.variables(data, nil)); err != nil {
+		toPrint := fmt.Sprintf("MC client 'CreateOrUpdateCollectionBlock' mutation result - %s", err.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+		return
+	}
+
+	data.Uuid = types.StringValue(createResult.CreateOrUpdateCollectionBlock.CollectionBlock.Uuid)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CollectionBlockResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CollectionBlockResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getResult := client.GetCollectionBlocks{}
+	variables := map[string]interface{}{"resourceId": client.UUID(data.WarehouseUuid.ValueString())}
+	if err := r.client.Query(ctx, &getResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'GetCollectionBlocks' query result - %s", err.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+		return
+	}
+
+	for _, block := range getResult.GetCollectionBlocks {
+		if block.Uuid == data.Uuid.ValueString() {
+			data.Mode = types.StringValue(block.Mode)
+			data.Project = types.StringPointerValue(block.Project)
+			data.Dataset = types.StringPointerValue(block.Dataset)
+			data.TablePattern = types.StringPointerValue(block.TablePattern)
+			data.CollectionTypes = common.TfStringsFrom(block.CollectionTypes)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	toPrint := fmt.Sprintf("MC client 'GetCollectionBlocks' query failed to find collection block [uuid: %s]. "+
+		"This resource will be removed from the Terraform state without deletion.", data.Uuid.ValueString())
+	resp.Diagnostics.AddWarning(toPrint, "")
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *CollectionBlockResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CollectionBlockResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(validateInclusion(data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uuid := client.UUID(data.Uuid.ValueString())
+	updateResult := client.CreateOrUpdateCollectionBlock{}
+	if err := r.client.Mutate(ctx, &updateResult, r.variables(data, &uuid)); err != nil {
+		toPrint := fmt.Sprintf("MC client 'CreateOrUpdateCollectionBlock' mutation result - %s", err.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CollectionBlockResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CollectionBlockResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DeletionProtection.ValueBool() && data.excludesProtectedDataset() {
+		resp.Diagnostics.AddError(
+			"Failed to delete collection block because deletion_protection is set to true. "+
+				"Set it to false to proceed with collection block deletion",
+			fmt.Sprintf("Collection block excludes scratch or PII dataset %q, its deletion would re-enable collection "+
+				"of the dataset by Monte Carlo.", data.Dataset.ValueString()),
+		)
+		return
+	}
+
+	deleteResult := client.DeleteCollectionBlock{}
+	variables := map[string]interface{}{"uuid": client.UUID(data.Uuid.ValueString())}
+	if err := r.client.Mutate(ctx, &deleteResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'DeleteCollectionBlock' mutation result - %s", err.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+	} else if !deleteResult.DeleteCollectionBlock.Success {
+		toPrint := "MC client 'DeleteCollectionBlock' mutation - success = false, " +
+			"collection block probably already doesn't exists. This resource will continue with its deletion"
+		resp.Diagnostics.AddWarning(toPrint, "")
+	}
+}
+
+func (r *CollectionBlockResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idsImported := strings.Split(req.ID, ",")
+	if len(idsImported) == 2 && idsImported[0] != "" && idsImported[1] != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("warehouse_uuid"), idsImported[0])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), idsImported[1])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), true)...)
+	} else {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <warehouse_uuid>,<collection_block_uuid>. Got: %q", req.ID),
+		)
+	}
+}
+
+func (r *CollectionBlockResource) variables(data CollectionBlockResourceModel, uuid *client.UUID) map[string]interface{} {
+	return map[string]interface{}{
+		"uuid":            uuid,
+		"resourceId":      client.UUID(data.WarehouseUuid.ValueString()),
+		"project":         data.Project.ValueStringPointer(),
+		"dataset":         data.Dataset.ValueStringPointer(),
+		"tablePattern":    data.TablePattern.ValueStringPointer(),
+		"mode":            client.CollectionBlockMode(data.Mode.ValueString()),
+		"collectionTypes": common.TfStringsTo[client.CollectionType](data.CollectionTypes),
+	}
+}
+
+// validateInclusion rejects INCLUDE rules of scratch or PII datasets, as well as INCLUDE rules not targeting
+// a specific dataset (project-level or table pattern rules), which would include protected datasets too.
+// Unknown values are skipped, they are validated again once known.
+func validateInclusion(data CollectionBlockResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	// mode which is not configured defaults to EXCLUDE
+	if data.Mode.ValueString() != collectionBlockInclude || data.Dataset.IsUnknown() {
+		return diags
+	}
+
+	if data.Dataset.IsNull() {
+		diags.AddAttributeError(path.Root("dataset"), "Protected dataset cannot be collected",
+			"INCLUDE rules must target a specific dataset, otherwise scratch and PII datasets, which must never be "+
+				"collected by Monte Carlo, would be included as well.")
+	} else if protectedDatasetRegex.MatchString(data.Dataset.ValueString()) {
+		diags.AddAttributeError(path.Root("dataset"), "Protected dataset cannot be collected",
+			fmt.Sprintf("Dataset %q is a scratch or PII dataset, which must never be collected by Monte Carlo. "+
+				"Only EXCLUDE mode is allowed for such datasets.", data.Dataset.ValueString()))
+	}
+	return diags
+}
+
+// collectsNoneOf reports whether the rule still excludes all the given collection types.
+func (m CollectionBlockResourceModel) collectsNoneOf(collectionTypes []types.String) bool {
+	for _, collectionType := range collectionTypes {
+		if !slices.ContainsFunc(m.CollectionTypes, func(planned types.String) bool { return planned.Equal(collectionType) }) {
+			return false
+		}
+	}
+	return true
+}
+
+// excludesProtectedDataset reports whether the rule excludes whole scratch or PII dataset from the collection.
+func (m CollectionBlockResourceModel) excludesProtectedDataset() bool {
+	return m.Mode.ValueString() == collectionBlockExclude && m.TablePattern.IsNull() &&
+		protectedDatasetRegex.MatchString(m.Dataset.ValueString())
+}
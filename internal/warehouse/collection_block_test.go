@@ -0,0 +1,121 @@
+package warehouse_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccCollectionBlockResource(t *testing.T) {
+	mc_api_key_id := os.Getenv("MC_API_KEY_ID")
+	mc_api_key_token := os.Getenv("MC_API_KEY_TOKEN")
+	warehouseUuid := "da6c0716-2724-4bfc-b5cc-7e0364faf979"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{ // Create and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("create.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_collection_block.test", "warehouse_uuid", warehouseUuid),
+					resource.TestCheckResourceAttr("montecarlo_collection_block.test", "mode", "EXCLUDE"),
+					resource.TestCheckResourceAttr("montecarlo_collection_block.test", "project", "data-playground-8bb9fc23"),
+					resource.TestCheckResourceAttr("montecarlo_collection_block.test", "dataset", "terraform_provider_montecarlo_scratch"),
+					resource.TestCheckNoResourceAttr("montecarlo_collection_block.test", "table_pattern"),
+					resource.TestCheckResourceAttr("montecarlo_collection_block.test", "collection_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr("montecarlo_collection_block.test", "collection_types.*", "METADATA"),
+					resource.TestCheckResourceAttrSet("montecarlo_collection_block.test", "uuid"),
+					resource.TestCheckResourceAttr("montecarlo_collection_block.test", "deletion_protection", "true"),
+				),
+			},
+			{ // ImportState testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				ResourceName:                         "montecarlo_collection_block.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					uuid := s.RootModule().Resources["montecarlo_collection_block.test"].Primary.Attributes["uuid"]
+					return fmt.Sprintf("%[1]s,%[2]s", warehouseUuid, uuid), nil
+				},
+			},
+			{ // Exclusion of scratch and PII datasets cannot be changed unless deletion_protection is disabled
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("change_protected.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				ExpectError: regexp.MustCompile(`deletion_protection is set to true`),
+			},
+			{ // Excluded collection types of scratch and PII datasets cannot be swapped for others either
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("swap_protected.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				ExpectError: regexp.MustCompile(`deletion_protection is set to true`),
+			},
+			{ // Disabling deletion_protection
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("unprotect.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_collection_block.test", "deletion_protection", "false"),
+					resource.TestCheckResourceAttr("montecarlo_collection_block.test", "collection_types.#", "2"),
+				),
+			},
+			{ // Update and Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("update.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("montecarlo_collection_block.test", "table_pattern", "^tmp_.*"),
+					resource.TestCheckResourceAttr("montecarlo_collection_block.test", "collection_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr("montecarlo_collection_block.test", "collection_types.*", "QUERY_LOGS"),
+				),
+			},
+			{ // Scratch and PII datasets must never be included in the collection
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("protected_dataset.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				ExpectError: regexp.MustCompile(`Protected dataset cannot be collected`),
+			},
+			{ // INCLUDE rules must target a specific dataset, which is not a scratch or PII dataset
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("project_include.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				ExpectError: regexp.MustCompile(`INCLUDE rules must target a specific dataset`),
+			},
+		},
+	})
+}
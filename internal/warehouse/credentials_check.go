@@ -0,0 +1,227 @@
+package warehouse
+
+import (
+	"context"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &CredentialsCheckEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &CredentialsCheckEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigValidators = &CredentialsCheckEphemeralResource{}
+
+// To simplify provider implementations, a named function can be created with the resource implementation.
+func NewCredentialsCheckEphemeralResource() ephemeral.EphemeralResource {
+	return &CredentialsCheckEphemeralResource{}
+}
+
+// CredentialsCheckEphemeralResource defines the ephemeral resource implementation. It runs the same
+// credentials test as warehouse resources do during apply, so that credentials can be validated during plan.
+type CredentialsCheckEphemeralResource struct {
+	client client.MonteCarloClient
+}
+
+// CredentialsCheckEphemeralResourceModel describes the ephemeral resource data model according to its Schema.
+type CredentialsCheckEphemeralResourceModel struct {
+	BigQuery      *BigQueryCredentialsCheckModel      `tfsdk:"bigquery"`
+	Transactional *TransactionalCredentialsCheckModel `tfsdk:"transactional"`
+	FailOnError   types.Bool                          `tfsdk:"fail_on_error"`
+	Success       types.Bool                          `tfsdk:"success"`
+	Warnings      []CredentialsCheckDiagnosticModel   `tfsdk:"warnings"`
+	Errors        []CredentialsCheckDiagnosticModel   `tfsdk:"errors"`
+}
+
+type BigQueryCredentialsCheckModel struct {
+	CollectorUuid     types.String `tfsdk:"collector_uuid"`
+	ServiceAccountKey types.String `tfsdk:"service_account_key"`
+}
+
+type TransactionalCredentialsCheckModel struct {
+	DbType   types.String `tfsdk:"db_type"`
+	Host     types.String `tfsdk:"host"`
+	Port     types.Int64  `tfsdk:"port"`
+	Database types.String `tfsdk:"database"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+type CredentialsCheckDiagnosticModel struct {
+	Message    types.String `tfsdk:"message"`
+	Resolution types.String `tfsdk:"resolution"`
+}
+
+func (r *CredentialsCheckEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credentials_check"
+}
+
+func (r *CredentialsCheckEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	diagnosticObject := schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"message": schema.StringAttribute{
+				Computed: true,
+			},
+			"resolution": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"bigquery": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"collector_uuid": schema.StringAttribute{
+						Required: true,
+					},
+					"service_account_key": schema.StringAttribute{
+						Required:  true,
+						Sensitive: true,
+					},
+				},
+			},
+			"transactional": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"db_type": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("POSTGRES", "MYSQL", "SQL-SERVER"),
+						},
+					},
+					"host": schema.StringAttribute{
+						Required: true,
+					},
+					"port": schema.Int64Attribute{
+						Required: true,
+					},
+					"database": schema.StringAttribute{
+						Required: true,
+					},
+					"username": schema.StringAttribute{
+						Required:  true,
+						Sensitive: true,
+					},
+					"password": schema.StringAttribute{
+						Required:  true,
+						Sensitive: true,
+					},
+				},
+			},
+			"fail_on_error": schema.BoolAttribute{
+				Optional: true,
+			},
+			"success": schema.BoolAttribute{
+				Computed: true,
+			},
+			"warnings": schema.ListNestedAttribute{
+				Computed:     true,
+				NestedObject: diagnosticObject,
+			},
+			"errors": schema.ListNestedAttribute{
+				Computed:     true,
+				NestedObject: diagnosticObject,
+			},
+		},
+	}
+}
+
+func (r *CredentialsCheckEphemeralResource) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		ephemeralvalidator.ExactlyOneOf(
+			path.MatchRoot("bigquery"),
+			path.MatchRoot("transactional"),
+		),
+	}
+}
+
+func (r *CredentialsCheckEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *CredentialsCheckEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data CredentialsCheckEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// warnings are always reported, errors only if the check should block the Terraform run
+	var warnings, errors diag.Diagnostics
+	data.Warnings, data.Errors = []CredentialsCheckDiagnosticModel{}, []CredentialsCheckDiagnosticModel{}
+	if data.BigQuery != nil {
+		testResult, diags := testBqCredentials(ctx, r.client, data.BigQuery.CollectorUuid.ValueString(), data.BigQuery.ServiceAccountKey.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		validationResult := testResult.TestBqCredentialsV2.ValidationResult
+		data.Success = types.BoolValue(validationResult.Success)
+		attribute := path.Root("bigquery").AtName("service_account_key")
+		warnings = bqTestDiagnosticToDiags(validationResult.Warnings, attribute)
+		errors = bqTestDiagnosticToDiags(validationResult.Errors, attribute)
+		for _, warning := range validationResult.Warnings {
+			data.Warnings = append(data.Warnings, newCredentialsCheckDiagnosticModel(warning.FriendlyMessage, warning.Resolution))
+		}
+		for _, err := range validationResult.Errors {
+			data.Errors = append(data.Errors, newCredentialsCheckDiagnosticModel(err.FriendlyMessage, err.Resolution))
+		}
+	} else {
+		credentials := TransactionalCredentials{
+			Host:     data.Transactional.Host,
+			Port:     data.Transactional.Port,
+			Database: data.Transactional.Database,
+			Username: data.Transactional.Username,
+			Password: data.Transactional.Password,
+		}
+
+		testResult, diags := testDatabaseCredentials(ctx, r.client, data.Transactional.DbType.ValueString(), credentials)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Success = types.BoolValue(testResult.TestDatabaseCredentials.Success)
+		attribute := path.Root("transactional")
+		warnings = databaseTestDiagnosticsToDiags(testResult.TestDatabaseCredentials.Warnings, attribute)
+		for _, warning := range testResult.TestDatabaseCredentials.Warnings {
+			data.Warnings = append(data.Warnings, newCredentialsCheckDiagnosticModel(warning.Message, warning.Type))
+		}
+		if !testResult.TestDatabaseCredentials.Success { // validations explain failed credentials test
+			for _, validation := range testResult.TestDatabaseCredentials.Validations {
+				errors.AddAttributeError(databaseTestAttribute(attribute, validation.Type), validation.Message, validation.Type)
+				data.Errors = append(data.Errors, newCredentialsCheckDiagnosticModel(validation.Message, validation.Type))
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(warnings...)
+	if data.FailOnError.ValueBool() && !data.Success.ValueBool() {
+		if !errors.HasError() {
+			errors.AddError("Credentials check failed", "Monte Carlo did not report the reason of the failure.")
+		}
+		resp.Diagnostics.Append(errors...)
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func newCredentialsCheckDiagnosticModel(message string, resolution string) CredentialsCheckDiagnosticModel {
+	return CredentialsCheckDiagnosticModel{
+		Message:    types.StringValue(message),
+		Resolution: types.StringValue(resolution),
+	}
+}
@@ -0,0 +1,44 @@
+package warehouse_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccCredentialsCheckEphemeralResource(t *testing.T) {
+	mc_api_key_id := os.Getenv("MC_API_KEY_ID")
+	mc_api_key_token := os.Getenv("MC_API_KEY_TOKEN")
+	serviceAccount := os.Getenv("BQ_SERVICE_ACCOUNT")
+
+	if serviceAccount == "" {
+		t.Fatalf("'BQ_SERVICE_ACCOUNT' must be set for this acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0), // ephemeral resources
+		},
+		Steps: []resource.TestStep{
+			{ // Open testing
+				ProtoV6ProviderFactories: acctest.TestAccEphemeralProviderFactories,
+				ConfigFile:               config.TestNameFile("open.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+					"bq_service_account":       config.StringVariable(serviceAccount),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("echo.test", "data.success", "true"),
+					resource.TestCheckResourceAttr("echo.test", "data.errors.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,213 @@
+package warehouse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &KeyAssetResource{}
+var _ resource.ResourceWithImportState = &KeyAssetResource{}
+
+// To simplify provider implementations, a named function can be created with the resource implementation.
+func NewKeyAssetResource() resource.Resource {
+	return &KeyAssetResource{}
+}
+
+// KeyAssetResource defines the resource implementation.
+type KeyAssetResource struct {
+	client client.MonteCarloClient
+}
+
+// KeyAssetResourceModel describes the resource data model according to its Schema.
+type KeyAssetResourceModel struct {
+	Mcon            common.MconValue `tfsdk:"mcon"`
+	KeyAsset        types.Bool       `tfsdk:"key_asset"`
+	Reason          types.String     `tfsdk:"reason"`
+	ImportanceScore types.Float64    `tfsdk:"importance_score"`
+}
+
+func (r *KeyAssetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_key_asset"
+}
+
+func (r *KeyAssetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"mcon": schema.StringAttribute{
+				Required:   true,
+				CustomType: common.MconType{},
+				Validators: []validator.String{common.MconValidator()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key_asset": schema.BoolAttribute{
+				Required: true,
+			},
+			"reason": schema.StringAttribute{
+				Required:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"importance_score": schema.Float64Attribute{
+				Computed: true,
+				Optional: false,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *KeyAssetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	r.client = client
+}
+
+func (r *KeyAssetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data KeyAssetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setOverride(ctx, &data)...)
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+func (r *KeyAssetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data KeyAssetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	table, diags := r.getTable(ctx, data.Mcon)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	} else if table == nil || table.KeyAssetOverride == nil {
+		toPrint := fmt.Sprintf("MC client 'GetTable' query failed to find key asset override [mcon: %s]. "+
+			"This resource will be removed from the Terraform state without deletion.", data.Mcon.ValueString())
+		resp.Diagnostics.AddWarning(toPrint, "")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.KeyAsset = types.BoolValue(table.KeyAssetOverride.IsKeyAsset)
+	data.Reason = types.StringValue(table.KeyAssetOverride.Reason)
+	data.ImportanceScore = types.Float64Value(table.ImportanceScore)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KeyAssetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data KeyAssetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setOverride(ctx, &data)...)
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+func (r *KeyAssetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data KeyAssetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteResult := client.DeleteKeyAssetOverride{}
+	variables := map[string]interface{}{"mcon": data.Mcon.Normalized()}
+	if err := r.client.Mutate(ctx, &deleteResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'DeleteKeyAssetOverride' mutation result - %s", err.Error())
+		resp.Diagnostics.AddError(toPrint, "")
+	} else if !deleteResult.DeleteKeyAssetOverride.Success {
+		toPrint := "MC client 'DeleteKeyAssetOverride' mutation - success = false, " +
+			"key asset override probably already doesn't exists. This resource will continue with its deletion"
+		resp.Diagnostics.AddWarning(toPrint, "")
+	}
+}
+
+func (r *KeyAssetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, err := common.ParseMcon(req.ID); err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <mcon>. Got: %q - %s", req.ID, err.Error()),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mcon"), common.NewMconValue(common.NormalizeMcon(req.ID)))...)
+}
+
+// setOverride overrides automatic importance of the table and reads back its current importance score,
+// unless the score is already known from the plan (kept from the state until refreshed by Read).
+func (r *KeyAssetResource) setOverride(ctx context.Context, data *KeyAssetResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	setResult := client.SetKeyAssetOverride{}
+	variables := map[string]interface{}{
+		"mcon":       data.Mcon.Normalized(),
+		"isKeyAsset": data.KeyAsset.ValueBool(),
+		"reason":     data.Reason.ValueString(),
+	}
+
+	if err := r.client.Mutate(ctx, &setResult, variables); err != nil {
+		toPrint := fmt.Sprintf("MC client 'SetKeyAssetOverride' mutation result - %s", err.Error())
+		diags.AddError(toPrint, "")
+		return diags
+	} else if !setResult.SetKeyAssetOverride.Success {
+		diags.AddAttributeError(path.Root("mcon"), "MC client 'SetKeyAssetOverride' mutation - success = false, "+
+			"Monte Carlo failed to override importance of the table.", "")
+		return diags
+	} else if !data.ImportanceScore.IsUnknown() {
+		return diags
+	}
+
+	table, getDiags := r.getTable(ctx, data.Mcon)
+	diags.Append(getDiags...)
+	if table == nil {
+		data.ImportanceScore = types.Float64Null()
+	} else {
+		data.ImportanceScore = types.Float64Value(table.ImportanceScore)
+	}
+	return diags
+}
+
+func (r *KeyAssetResource) getTable(ctx context.Context, mcon common.MconValue) (*client.Table, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	getResult := client.GetTable{}
+	variables := map[string]interface{}{"mcon": mcon.Normalized()}
+
+	if bytes, err := r.client.ExecRaw(ctx, client.GetTableQuery, variables); err != nil && len(bytes) == 0 {
+		toPrint := fmt.Sprintf("MC client 'GetTable' query result - %s", err.Error())
+		diags.AddError(toPrint, "")
+		return nil, diags
+	} else if jsonErr := json.Unmarshal(bytes, &getResult); jsonErr != nil {
+		toPrint := fmt.Sprintf("MC client 'GetTable' query failed to unmarshal data - %s", jsonErr.Error())
+		diags.AddError(toPrint, "")
+		return nil, diags
+	}
+	return getResult.GetTable, diags
+}
@@ -0,0 +1,117 @@
+package warehouse
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/client"
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &KeyAssetsDataSource{}
+
+func NewKeyAssetsDatasource() datasource.DataSource {
+	return &KeyAssetsDataSource{}
+}
+
+type KeyAssetsDataSource struct {
+	client client.MonteCarloClient
+}
+
+type KeyAssetsDataSourceModel struct {
+	WarehouseUuid types.String                       `tfsdk:"warehouse_uuid"`
+	KeyAssets     map[string]KeyAssetDataSourceModel `tfsdk:"key_assets"`
+}
+
+type KeyAssetDataSourceModel struct {
+	Project         types.String  `tfsdk:"project"`
+	Dataset         types.String  `tfsdk:"dataset"`
+	Table           types.String  `tfsdk:"table"`
+	ImportanceScore types.Float64 `tfsdk:"importance_score"`
+}
+
+func (d *KeyAssetsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_key_assets"
+}
+
+func (d *KeyAssetsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"warehouse_uuid": schema.StringAttribute{
+				Required: true,
+			},
+			"key_assets": schema.MapNestedAttribute{
+				Computed: true,
+				Optional: false,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"project": schema.StringAttribute{
+							Computed: true,
+						},
+						"dataset": schema.StringAttribute{
+							Computed: true,
+						},
+						"table": schema.StringAttribute{
+							Computed: true,
+						},
+						"importance_score": schema.Float64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *KeyAssetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := common.Configure(req)
+	resp.Diagnostics.Append(diags...)
+	d.client = client
+}
+
+func (d *KeyAssetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data KeyAssetsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasNextPage := true
+	data.KeyAssets = map[string]KeyAssetDataSourceModel{}
+	variables := map[string]interface{}{
+		"dwId":       client.UUID(data.WarehouseUuid.ValueString()),
+		"first":      500,
+		"after":      (*string)(nil),
+		"isDeleted":  false,
+		"isExcluded": false,
+	}
+
+	for hasNextPage {
+		readResult := client.GetKeyAssetTables{}
+		if err := d.client.Query(ctx, &readResult, variables); err != nil {
+			toPrint := fmt.Sprintf("MC client 'getTables' query result - %s", err.Error())
+			resp.Diagnostics.AddError(toPrint, "")
+			return
+		}
+
+		hasNextPage = readResult.GetTables.PageInfo.HasNextPage
+		variables["after"] = readResult.GetTables.PageInfo.EndCursor
+
+		for _, element := range readResult.GetTables.Edges {
+			if element.Node.IsImportant {
+				data.KeyAssets[common.NormalizeMcon(element.Node.Mcon)] = KeyAssetDataSourceModel{
+					Project:         types.StringValue(element.Node.ProjectName),
+					Dataset:         types.StringValue(element.Node.Dataset),
+					Table:           types.StringValue(element.Node.TableId),
+					ImportanceScore: types.Float64Value(element.Node.ImportanceScore),
+				}
+			}
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
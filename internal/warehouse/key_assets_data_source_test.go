@@ -0,0 +1,37 @@
+package warehouse_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/kiwicom/terraform-provider-montecarlo/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccKeyAssetsDataSource(t *testing.T) {
+	mc_api_key_id := os.Getenv("MC_API_KEY_ID")
+	mc_api_key_token := os.Getenv("MC_API_KEY_TOKEN")
+	personMcon := "MCON++3e9abc75-5dc1-447e-b4cb-9d5a6fc5db5c++da6c0716-2724-4bfc-b5cc-7e0364faf979++table++data-playground-8bb9fc23:terraform_provider_montecarlo.person"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{ // Read testing
+				ProtoV6ProviderFactories: acctest.TestAccProviderFactories,
+				ConfigFile:               config.TestNameFile("read.tf"),
+				ConfigVariables: config.Variables{
+					"montecarlo_api_key_id":    config.StringVariable(mc_api_key_id),
+					"montecarlo_api_key_token": config.StringVariable(mc_api_key_token),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.montecarlo_key_assets.test", "warehouse_uuid", "da6c0716-2724-4bfc-b5cc-7e0364faf979"),
+					resource.TestCheckResourceAttr("data.montecarlo_key_assets.test", "key_assets."+personMcon+".project", "data-playground-8bb9fc23"),
+					resource.TestCheckResourceAttr("data.montecarlo_key_assets.test", "key_assets."+personMcon+".dataset", "terraform_provider_montecarlo"),
+					resource.TestCheckResourceAttr("data.montecarlo_key_assets.test", "key_assets."+personMcon+".table", "person"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,22 @@
+variable "montecarlo_api_key_id" {
+  type = string
+}
+
+variable "montecarlo_api_key_token" {
+  type = string
+}
+
+provider "montecarlo" {
+  account_service_key = {
+    id    = var.montecarlo_api_key_id     # (secret)
+    token = var.montecarlo_api_key_token  # (secret)
+  }
+}
+
+resource "montecarlo_collection_block" "test" {
+  warehouse_uuid = "da6c0716-2724-4bfc-b5cc-7e0364faf979"
+  project        = "data-playground-8bb9fc23"
+  dataset        = "terraform_provider_montecarlo_scratch"
+
+  deletion_protection = false
+}
@@ -0,0 +1,37 @@
+variable "montecarlo_api_key_id" {
+  type = string
+}
+
+variable "montecarlo_api_key_token" {
+  type = string
+}
+
+provider "montecarlo" {
+  account_service_key = {
+    id    = var.montecarlo_api_key_id     # (secret)
+    token = var.montecarlo_api_key_token  # (secret)
+  }
+}
+
+variable "bq_service_account" {
+  type = string
+}
+
+
+ephemeral "montecarlo_credentials_check" "test" {
+  bigquery = {
+    collector_uuid      = "9d1aee0a-6a90-47f0-8221-a884be707fc4"
+    service_account_key = var.bq_service_account
+  }
+  fail_on_error = true
+}
+
+provider "echo" {
+  data = {
+    success  = ephemeral.montecarlo_credentials_check.test.success
+    warnings = ephemeral.montecarlo_credentials_check.test.warnings
+    errors   = ephemeral.montecarlo_credentials_check.test.errors
+  }
+}
+
+resource "echo" "test" {}
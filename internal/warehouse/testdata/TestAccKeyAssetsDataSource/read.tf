@@ -0,0 +1,25 @@
+variable "montecarlo_api_key_id" {
+  type = string
+}
+
+variable "montecarlo_api_key_token" {
+  type = string
+}
+
+provider "montecarlo" {
+  account_service_key = {
+    id    = var.montecarlo_api_key_id     # (secret)
+    token = var.montecarlo_api_key_token  # (secret)
+  }
+}
+
+resource "montecarlo_key_asset" "test" {
+  mcon      = "MCON++3e9abc75-5dc1-447e-b4cb-9d5a6fc5db5c++da6c0716-2724-4bfc-b5cc-7e0364faf979++table++data-playground-8bb9fc23:terraform_provider_montecarlo.person"
+  key_asset = true
+  reason    = "Terraform provider acceptance test"
+}
+
+data "montecarlo_key_assets" "test" {
+  warehouse_uuid = "da6c0716-2724-4bfc-b5cc-7e0364faf979"
+  depends_on     = [montecarlo_key_asset.test]
+}
@@ -42,6 +42,7 @@ type TransactionalWarehouseResourceModel struct {
 	CollectorUuid      types.String             `tfsdk:"collector_uuid"`
 	Credentials        TransactionalCredentials `tfsdk:"credentials"`
 	DeletionProtection types.Bool               `tfsdk:"deletion_protection"`
+	CredentialsDrifted types.Bool               `tfsdk:"credentials_drifted"`
 }
 
 type TransactionalCredentials struct {
@@ -147,6 +148,13 @@ func (r *TransactionalWarehouseResource) Schema(ctx context.Context, req resourc
 				Computed: true,
 				Default:  booldefault.StaticBool(true),
 			},
+			"credentials_drifted": schema.BoolAttribute{
+				Computed: true,
+				Optional: false,
+				PlanModifiers: []planmodifier.Bool{
+					common.RotateCredentialsIfDrifted(),
+				},
+			},
 		},
 	}
 }
@@ -173,6 +181,7 @@ func (r *TransactionalWarehouseResource) Create(ctx context.Context, req resourc
 	data.Uuid = types.StringValue(result.AddConnection.Connection.Warehouse.Uuid)
 	data.Credentials.UpdatedAt = types.StringValue(result.AddConnection.Connection.CreatedOn)
 	data.Credentials.ConnectionUuid = types.StringValue(result.AddConnection.Connection.Uuid)
+	data.CredentialsDrifted = types.BoolValue(false)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -242,17 +251,22 @@ func (r *TransactionalWarehouseResource) Read(ctx context.Context, req resource.
 			if connection.UpdatedOn == "" {
 				readCredentials.UpdatedAt = types.StringValue(connection.CreatedOn)
 			}
-		}
-	}
 
-	if !readCredentials.ConnectionUuid.IsNull() && !readCredentials.UpdatedAt.Equal(data.Credentials.UpdatedAt) {
-		readCredentials.Host = types.StringValue("(unknown remote value)")
-		readCredentials.Port = types.Int64Value(-1)
-		readCredentials.Database = types.StringValue("(unknown remote value)")
-		readCredentials.Username = types.StringValue("(unknown remote value)")
-		readCredentials.Password = types.StringValue("(unknown remote value)")
+			// non-secret connection details are read back, secrets can only be detected as drifted.
+			// Configured values are kept unless they differ semantically from the normalized values of the API
+			if connection.Details.Host != nil && !strings.EqualFold(readCredentials.Host.ValueString(), *connection.Details.Host) {
+				readCredentials.Host = types.StringValue(*connection.Details.Host)
+			}
+			if connection.Details.Port != nil && (readCredentials.Port.IsNull() || readCredentials.Port.ValueInt64() != *connection.Details.Port) {
+				readCredentials.Port = types.Int64Value(*connection.Details.Port)
+			}
+			if connection.Details.Database != nil && !strings.EqualFold(readCredentials.Database.ValueString(), *connection.Details.Database) {
+				readCredentials.Database = types.StringValue(*connection.Details.Database)
+			}
+		}
 	}
 
+	data.CredentialsDrifted = common.CredentialsDrifted(data.CredentialsDrifted, data.Credentials.UpdatedAt, readCredentials.UpdatedAt)
 	data.Credentials = readCredentials
 	data.Name = types.StringValue(getResult.GetWarehouse.Name)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -291,7 +305,9 @@ func (r *TransactionalWarehouseResource) Update(ctx context.Context, req resourc
 	if updateResult, diags := updateConnection(ctx, r.client, r, data, TrxKeyExtractor); updateResult == nil {
 		resp.Diagnostics.Append(diags...)
 	} else {
+		resp.Diagnostics.Append(diags...) // non-blocking credentials warnings
 		data.Credentials.UpdatedAt = types.StringValue(updateResult.UpdateCredentialsV2.UpdatedAt)
+		data.CredentialsDrifted = types.BoolValue(false)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	}
 }
@@ -339,40 +355,77 @@ func (r *TransactionalWarehouseResource) ImportState(ctx context.Context, req re
 }
 
 func (r *TransactionalWarehouseResource) testCredentials(ctx context.Context, data TransactionalWarehouseResourceModel) (*client.TestDatabaseCredentials, diag.Diagnostics) {
+	testResult, diagsResult := testDatabaseCredentials(ctx, r.client, data.DbType.ValueString(), data.Credentials)
+	if testResult == nil {
+		return nil, diagsResult
+	}
+
+	// non-blocking warnings are reported even on success
+	attribute := path.Root("credentials")
+	diagsResult.Append(databaseTestDiagnosticsToDiags(testResult.TestDatabaseCredentials.Warnings, attribute)...)
+	if !testResult.TestDatabaseCredentials.Success { // validations explain failed credentials test
+		for _, validation := range testResult.TestDatabaseCredentials.Validations {
+			diagsResult.AddAttributeError(databaseTestAttribute(attribute, validation.Type), validation.Message, validation.Type)
+		}
+		if !diagsResult.HasError() {
+			diagsResult.AddAttributeError(attribute, "MC client 'TestDatabaseCredentials' mutation - success = false, "+
+				"Monte Carlo failed to connect to the database with provided credentials.", "")
+		}
+		return nil, diagsResult
+	}
+	return testResult, diagsResult
+}
+
+// testDatabaseCredentials only reports failures of the API call itself, validation result is left to the caller.
+func testDatabaseCredentials(ctx context.Context, mcClient client.MonteCarloClient, dbType string, credentials TransactionalCredentials) (*client.TestDatabaseCredentials, diag.Diagnostics) {
 	var diagsResult diag.Diagnostics
 	testResult := client.TestDatabaseCredentials{}
 	variables := map[string]interface{}{
 		"connectionType": client.TrxConnectionType,
-		"dbType":         strings.ToLower(data.DbType.ValueString()),
-		"host":           data.Credentials.Host.ValueString(),
-		"port":           data.Credentials.Port.ValueInt64(),
-		"dbName":         data.Credentials.Database.ValueString(),
-		"user":           data.Credentials.Username.ValueString(),
-		"password":       data.Credentials.Password.ValueString(),
+		"dbType":         strings.ToLower(dbType),
+		"host":           credentials.Host.ValueString(),
+		"port":           credentials.Port.ValueInt64(),
+		"dbName":         credentials.Database.ValueString(),
+		"user":           credentials.Username.ValueString(),
+		"password":       credentials.Password.ValueString(),
 	}
 
-	if err := r.client.Mutate(ctx, &testResult, variables); err != nil {
+	if err := mcClient.Mutate(ctx, &testResult, variables); err != nil {
 		toPrint := fmt.Sprintf("MC client 'TestDatabaseCredentials' mutation result - %s", err.Error())
 		diagsResult.AddError(toPrint, "")
 		return nil, diagsResult
-	} else if !testResult.TestDatabaseCredentials.Success {
-		diags := databaseTestDiagnosticsToDiags(testResult.TestDatabaseCredentials.Warnings)
-		diags = append(diags, databaseTestDiagnosticsToDiags(testResult.TestDatabaseCredentials.Validations)...)
-		diagsResult.Append(diags...)
-		return nil, diagsResult
-	} else {
-		return &testResult, diagsResult
 	}
+	return &testResult, diagsResult
 }
 
-func databaseTestDiagnosticsToDiags(in []client.DatabaseTestDiagnostic) diag.Diagnostics {
+// databaseTestDiagnosticsToDiags reports non-blocking warnings of the credentials test.
+func databaseTestDiagnosticsToDiags(in []client.DatabaseTestDiagnostic, credentials path.Path) diag.Diagnostics {
 	var diags diag.Diagnostics
 	for _, value := range in {
-		diags.AddWarning(value.Message, value.Type)
+		diags.AddAttributeWarning(databaseTestAttribute(credentials, value.Type), value.Message, value.Type)
 	}
 	return diags
 }
 
+// databaseTestAttribute points the diagnostic to the credentials field the validation is about,
+// falling back to the whole credentials if the validation type is not recognized.
+func databaseTestAttribute(credentials path.Path, validationType string) path.Path {
+	validationType = strings.ToLower(validationType)
+	for _, field := range []struct{ keyword, name string }{
+		{"password", "password"},
+		{"user", "username"},
+		{"host", "host"},
+		{"port", "port"},
+		{"database", "database"},
+		{"db_name", "database"},
+	} {
+		if strings.Contains(validationType, field.keyword) {
+			return credentials.AtName(field.name)
+		}
+	}
+	return credentials
+}
+
 func (r *TransactionalWarehouseResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	return map[int64]resource.StateUpgrader{
 		0: {
@@ -447,10 +500,11 @@ func (r *TransactionalWarehouseResource) UpgradeState(ctx context.Context) map[i
 				resp.Diagnostics.Append(req.State.Get(ctx, &priorStateData)...)
 				if !resp.Diagnostics.HasError() {
 					upgradedStateData := TransactionalWarehouseResourceModel{
-						Uuid:          priorStateData.Uuid,
-						CollectorUuid: priorStateData.CollectorUuid,
-						Name:          priorStateData.Name,
-						DbType:        priorStateData.DbType,
+						Uuid:               priorStateData.Uuid,
+						CollectorUuid:      priorStateData.CollectorUuid,
+						Name:               priorStateData.Name,
+						DbType:             priorStateData.DbType,
+						CredentialsDrifted: types.BoolValue(false),
 						Credentials: TransactionalCredentials{
 							ConnectionUuid: priorStateData.ConnectionUuid,
 							Host:           priorStateData.Configuration.Host,
@@ -63,6 +63,7 @@ func TestAccTransactionalWarehouseResource(t *testing.T) {
 					resource.TestCheckResourceAttr("montecarlo_transactional_warehouse.test", "credentials.database", pgDatabase),
 					resource.TestCheckResourceAttr("montecarlo_transactional_warehouse.test", "credentials.username", pgUser),
 					resource.TestCheckResourceAttr("montecarlo_transactional_warehouse.test", "credentials.password", pgPassword),
+					resource.TestCheckResourceAttr("montecarlo_transactional_warehouse.test", "credentials_drifted", "false"),
 				),
 			},
 			{ // ImportState testing
@@ -108,6 +109,7 @@ func TestAccTransactionalWarehouseResource(t *testing.T) {
 					resource.TestCheckResourceAttr("montecarlo_transactional_warehouse.test", "credentials.database", pgDatabase),
 					resource.TestCheckResourceAttr("montecarlo_transactional_warehouse.test", "credentials.username", pgUser),
 					resource.TestCheckResourceAttr("montecarlo_transactional_warehouse.test", "credentials.password", pgPassword),
+					resource.TestCheckResourceAttr("montecarlo_transactional_warehouse.test", "credentials_drifted", "false"),
 				),
 			},
 		},
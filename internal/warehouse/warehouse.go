@@ -123,22 +123,20 @@ func (d *WarehouseDataSource) Read(ctx context.Context, req datasource.ReadReque
 		for _, element := range readResult.GetTables.Edges {
 			project := data.Projects[element.Node.ProjectName]
 			if project.Datasets == nil {
-				project.Mcon = types.StringValue(fmt.Sprintf(
-					"MCON++%s++%s++project++%s",
+				project.Mcon = types.StringValue(common.ProjectMcon(
 					element.Node.Warehouse.Account.Uuid,
 					element.Node.Warehouse.Uuid,
-					element.Node.ProjectName))
+					element.Node.ProjectName).String())
 				project.Datasets = map[string]WarehouseDatasetDataSourceModel{}
 			}
 
 			dataset := project.Datasets[element.Node.Dataset]
 			if dataset.Tables == nil {
-				dataset.Mcon = types.StringValue(fmt.Sprintf(
-					"MCON++%s++%s++dataset++%s:%s",
+				dataset.Mcon = types.StringValue(common.DatasetMcon(
 					element.Node.Warehouse.Account.Uuid,
 					element.Node.Warehouse.Uuid,
 					element.Node.ProjectName,
-					element.Node.Dataset))
+					element.Node.Dataset).String())
 				dataset.Tables = map[string]WarehouseTableDataSourceModel{}
 			}
 